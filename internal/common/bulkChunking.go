@@ -0,0 +1,210 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//splitBulkInputs splits the inputs into chunks which fit into one bulk API call
+func splitBulkInputs(inputs []BulkInput, chunkSize int) [][]BulkInput {
+	chunks := make([][]BulkInput, 0, (len(inputs)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(inputs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+		chunks = append(chunks, inputs[start:end])
+	}
+
+	return chunks
+}
+
+//sendChunkedBulk executes the inputs as several bulk calls of at most common.MaxBulkRequestsCount sub-requests,
+//runs not more than bulkConcurrency calls at once and merges their responses in the order of inputs. A failed call
+//doesn't stop the other ones, its sub-requests get the error status in the merged response
+func (cli *Client) sendChunkedBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (*common.RequestResult, error) {
+	chunks := splitBulkInputs(inputs, common.MaxBulkRequestsCount)
+	log.Log.Log(log.Debug, "will split %d bulk inputs into %d requests", len(inputs), len(chunks))
+
+	concurrency := cli.bulkConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]*common.RequestResult, len(chunks))
+	errs := make([]error, len(chunks))
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+
+	for i := range chunks {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() {
+				<-semaphore
+			}()
+
+			results[i], errs[i] = cli.handle(ctx, &common.RequestCall{
+				Params:       filters,
+				BulkRequests: chunks[i],
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	return mergeBulkResults(chunks, results, errs)
+}
+
+type rawBulkResponse struct {
+	Status   json.RawMessage   `json:"status"`
+	Requests []json.RawMessage `json:"requests"`
+}
+
+//mergeBulkResults joins the responses of chunked bulk calls into one response as if it was a single bulk call,
+//if a whole chunk was rejected or failed with an error, each of its sub-requests gets the error status of the chunk,
+//the error of a chunk without a response is kept in the SendError of its sub-requests.
+//The first error is returned only if none of the chunks got a response
+func mergeBulkResults(chunks [][]BulkInput, results []*common.RequestResult, errs []error) (*common.RequestResult, error) {
+	merged := rawBulkResponse{}
+	mergedResult := &common.RequestResult{}
+	var failedStatus json.RawMessage
+	var firstErr error
+
+	for i, res := range results {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			log.Log.Log(log.Warn, "bulk request of the inputs %d-%d failed: %v", i*common.MaxBulkRequestsCount, i*common.MaxBulkRequestsCount+len(chunks[i])-1, errs[i])
+
+			chunkStatus := chunkErrorStatus(errs[i])
+			for _, input := range chunks[i] {
+				itemStatus := failedBulkItemStatus(input, chunkStatus)
+				itemStatus.SendError = errs[i].Error()
+				if err := appendFailedBulkItem(&merged, mergedResult, itemStatus); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		chunkResp := rawBulkResponse{}
+		if err := json.Unmarshal(res.Body, &chunkResp); err != nil {
+			return nil, common.NewTransportError("failed to decode bulk response", err, res.Response.StatusCode, string(res.Body))
+		}
+
+		if res.Status != nil && IsJSONResponseOK(res.Status) {
+			if merged.Status == nil {
+				merged.Status = chunkResp.Status
+				mergedResult.Response = res.Response
+				mergedResult.SessionKey = res.SessionKey
+			}
+			merged.Requests = append(merged.Requests, chunkResp.Requests...)
+			mergedResult.BulkStatuses = append(mergedResult.BulkStatuses, res.BulkStatuses...)
+			continue
+		}
+
+		if failedStatus == nil {
+			failedStatus = chunkResp.Status
+			if merged.Status == nil {
+				mergedResult.Response = res.Response
+				mergedResult.SessionKey = res.SessionKey
+			}
+		}
+		for _, input := range chunks[i] {
+			if err := appendFailedBulkItem(&merged, mergedResult, failedBulkItemStatus(input, res.Status)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	//none of the chunks got a response which could be merged
+	if mergedResult.Response == nil {
+		return nil, firstErr
+	}
+
+	//all chunks were rejected, so the whole request failed
+	if merged.Status == nil {
+		merged.Status = failedStatus
+	}
+
+	body, err := json.Marshal(merged)
+	if err != nil {
+		return nil, common.NewFromError("failed to build bulk response", err, 0)
+	}
+
+	status := &common.Status{}
+	if err := json.Unmarshal(merged.Status, status); err == nil {
+		mergedResult.Status = status
+	}
+
+	resp := *mergedResult.Response
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header = resp.Header.Clone()
+	if resp.Header == nil {
+		resp.Header = http.Header{}
+	}
+	resp.Header.Del("Content-Length")
+
+	mergedResult.Response = &resp
+	mergedResult.Body = body
+
+	return mergedResult, nil
+}
+
+func appendFailedBulkItem(merged *rawBulkResponse, mergedResult *common.RequestResult, itemStatus common.StatusBulk) error {
+	rawItem, err := json.Marshal(map[string]interface{}{"status": itemStatus})
+	if err != nil {
+		return common.NewFromError("failed to build bulk response", err, 0)
+	}
+	merged.Requests = append(merged.Requests, rawItem)
+	mergedResult.BulkStatuses = append(mergedResult.BulkStatuses, itemStatus)
+
+	return nil
+}
+
+//chunkErrorStatus gives the error status for the sub-requests of a chunk which failed without a response
+func chunkErrorStatus(err error) *common.Status {
+	status := &common.Status{ResponseStatus: "error"}
+	var erplyErr *common.ErplyError
+	if errors.As(err, &erplyErr) {
+		status.ErrorCode = erplyErr.Code
+		status.ErrorField = erplyErr.ErrorField
+	}
+
+	return status
+}
+
+func failedBulkItemStatus(input BulkInput, chunkStatus *common.Status) common.StatusBulk {
+	itemStatus := common.StatusBulk{RequestName: input.MethodName}
+	if requestID, ok := input.Filters["requestID"]; ok {
+		itemStatus.RequestID = fmt.Sprint(requestID)
+	}
+	itemStatus.Request = input.MethodName
+	itemStatus.ResponseStatus = "error"
+	if chunkStatus != nil {
+		itemStatus.ErrorCode = chunkStatus.ErrorCode
+		itemStatus.ErrorField = chunkStatus.ErrorField
+	}
+
+	return itemStatus
+}
@@ -3,6 +3,9 @@ package common
 import (
 	"net/http"
 	"net/url"
+	"sync"
+
+	"github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type AuthFunc func(string) url.Values
@@ -39,6 +42,13 @@ type ClientConstructor struct {
 	httpCli                    *http.Client
 	headersForEveryRequestFunc AuthFunc
 	sessionProvider            SessionProvider
+	interceptors               []common.Interceptor
+	retryPolicies              *common.RetryPolicies
+	quotaTracker               *common.QuotaTracker
+	throttler                  common.Throttler
+	bulkConcurrency            int
+	metrics                    common.Metrics
+	tracer                     common.Tracer
 }
 
 func (cc *ClientConstructor) Build() *Client {
@@ -59,6 +69,43 @@ func (cc *ClientConstructor) Build() *Client {
 		clientCode:      cc.clientCode,
 		partnerKey:      cc.partnerKey,
 		headersFunc:     cc.headersForEveryRequestFunc,
+		interceptors:    cc.interceptors,
+		bulkConcurrency: cc.bulkConcurrency,
+		tracer:          cc.tracer,
+	}
+
+	if cli.tracer == nil {
+		cli.tracer = common.NoopTracer{}
+	}
+
+	if cli.bulkConcurrency <= 0 {
+		cli.bulkConcurrency = common.DefaultBulkConcurrency
+	}
+
+	metrics := cc.metrics
+	if metrics == nil {
+		metrics = common.NoopMetrics{}
+	}
+
+	if cc.retryPolicies != nil {
+		cli.builtinInterceptors = append(cli.builtinInterceptors, common.NewMeasuredRetryInterceptor(*cc.retryPolicies, metrics))
+	}
+
+	//a static session key cannot be renewed, so there is no sense to repeat requests with it
+	if _, isStatic := sessionProvider.(*DefaultSessionProvider); !isStatic {
+		cli.builtinInterceptors = append(cli.builtinInterceptors, cli.replayOnSessionExpiry)
+	}
+
+	if cc.quotaTracker != nil {
+		cli.builtinInterceptors = append(cli.builtinInterceptors, cc.quotaTracker.Interceptor())
+	}
+
+	if cc.throttler != nil {
+		cli.builtinInterceptors = append(cli.builtinInterceptors, common.MeasuredThrottleInterceptor(cc.throttler, metrics))
+	}
+
+	if cc.metrics != nil {
+		cli.builtinInterceptors = append(cli.builtinInterceptors, common.MetricsInterceptor(cc.metrics))
 	}
 
 	if cli.headersFunc == nil {
@@ -104,11 +151,66 @@ func (cc *ClientConstructor) WithSessionProvider(sessProv SessionProvider) {
 	cc.sessionProvider = sessProv
 }
 
+//WithInterceptors adds interceptors which will wrap every outgoing request, the first one is the outermost
+func (cc *ClientConstructor) WithInterceptors(interceptors ...common.Interceptor) {
+	cc.interceptors = append(cc.interceptors, interceptors...)
+}
+
+//WithRetryPolicy sets the policy for repeating failed requests of all API methods
+func (cc *ClientConstructor) WithRetryPolicy(policy common.RetryPolicy) {
+	if cc.retryPolicies == nil {
+		cc.retryPolicies = &common.RetryPolicies{}
+	}
+	cc.retryPolicies.Default = policy
+}
+
+//WithMethodRetryPolicy overrides the retry policy for the API method
+func (cc *ClientConstructor) WithMethodRetryPolicy(apiMethod string, policy common.RetryPolicy) {
+	if cc.retryPolicies == nil {
+		cc.retryPolicies = &common.RetryPolicies{}
+	}
+	if cc.retryPolicies.PerMethod == nil {
+		cc.retryPolicies.PerMethod = map[string]common.RetryPolicy{}
+	}
+	cc.retryPolicies.PerMethod[apiMethod] = policy
+}
+
+//WithQuotaTracker counts every request of the client against the hourly quota
+func (cc *ClientConstructor) WithQuotaTracker(qt *common.QuotaTracker) {
+	cc.quotaTracker = qt
+}
+
+//WithThrottler limits the requests rate of the client, e.g. with common.TokenBucketThrottler
+func (cc *ClientConstructor) WithThrottler(thrl common.Throttler) {
+	cc.throttler = thrl
+}
+
+//WithBulkConcurrency limits how many bulk calls can run at once when a bulk request with more than common.MaxBulkRequestsCount inputs is split
+func (cc *ClientConstructor) WithBulkConcurrency(concurrency int) {
+	cc.bulkConcurrency = concurrency
+}
+
+//WithMetrics reports the measurements of every API call, retry and throttle wait of the client, e.g. to common.MetricsRegistry
+func (cc *ClientConstructor) WithMetrics(metrics common.Metrics) {
+	cc.metrics = metrics
+}
+
+//WithTracer opens a span for every SendRequest and SendRequestBulk call of the client
+func (cc *ClientConstructor) WithTracer(tracer common.Tracer) {
+	cc.tracer = tracer
+}
+
 type SessionProvider interface {
 	GetSession() (sessionKey string, err error)
 	Invalidate()
 }
 
+//SessionErrorHandler can be implemented by the session providers whose credentials are rejected with other codes than
+//the session errors, e.g. JWTs. HandleError tells if the request should be repeated with new credentials
+type SessionErrorHandler interface {
+	HandleError(err error) bool
+}
+
 type DefaultSessionProvider struct {
 	SessionKey string
 }
@@ -129,6 +231,11 @@ type Client struct {
 	headersFunc                 AuthFunc
 	sessionProvider             SessionProvider
 	sendParametersInRequestBody bool
+	interceptors                []common.Interceptor
+	builtinInterceptors         []common.Interceptor
+	sessionRefreshLock          sync.Mutex
+	bulkConcurrency             int
+	tracer                      common.Tracer
 }
 
 //SendParametersInRequestBody indicates to the client that the request should add the data payload in the
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -14,10 +15,7 @@ import (
 	"github.com/erply/api-go-wrapper/pkg/api/log"
 )
 
-type BulkInput struct {
-	MethodName string
-	Filters    map[string]interface{}
-}
+type BulkInput = common.BulkInput
 
 func IsJSONResponseOK(responseStatus *common.Status) bool {
 	return strings.EqualFold(responseStatus.ResponseStatus, "ok")
@@ -56,16 +54,48 @@ func setParams(params url.Values, filters map[string]string) {
 }
 
 func (cli *Client) SendRequest(ctx context.Context, apiMethod string, filters map[string]string) (*http.Response, error) {
-	log.Log.Log(log.Debug, "will call %s with filters %+v", apiMethod, filters)
+	ctx, span := cli.tracer.StartSpan(ctx, common.SpanSendRequest)
+	defer span.End()
+	span.SetAttribute(common.AttrMethod, apiMethod)
+	span.SetAttribute(common.AttrClientCode, cli.clientCode)
+
+	res, err := cli.handle(ctx, &common.RequestCall{
+		ApiMethod: apiMethod,
+		Params:    filters,
+	})
+	common.SetResultAttributes(span, res, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Response, nil
+}
+
+func (cli *Client) handle(ctx context.Context, call *common.RequestCall) (*common.RequestResult, error) {
+	interceptors := make([]common.Interceptor, 0, len(cli.interceptors)+len(cli.builtinInterceptors))
+	interceptors = append(interceptors, cli.interceptors...)
+	interceptors = append(interceptors, cli.builtinInterceptors...)
+	handler := common.ChainInterceptors(cli.doCall, interceptors...)
+
+	return handler(ctx, call)
+}
+
+func (cli *Client) doCall(ctx context.Context, call *common.RequestCall) (*common.RequestResult, error) {
+	if call.IsBulk() {
+		return cli.doBulkCall(ctx, call)
+	}
+
+	apiMethod := call.ApiMethod
+	log.Record(log.Debug, "will call API method", log.F("method", apiMethod), log.F("filters", call.Params))
 	params := cli.headersFunc(apiMethod)
-	log.Log.Log(log.Debug, "extracted headers %+v", params)
+	log.Record(log.Debug, "extracted headers", log.F("method", apiMethod), log.F("headers", params))
 
 	params, err := cli.addSessionParams(params)
 	if err != nil {
 		return nil, err
 	}
 
-	setParams(params, filters)
+	setParams(params, call.Params)
 
 	var req *http.Request
 	if cli.sendParametersInRequestBody {
@@ -85,10 +115,58 @@ func (cli *Client) SendRequest(ctx context.Context, apiMethod string, filters ma
 
 	resp, err := doRequest(req, cli)
 	if err != nil {
-		return nil, common.NewFromError(fmt.Sprintf("%v request failed", apiMethod), err, 0)
+		return nil, common.NewTransportError(fmt.Sprintf("%v request failed", apiMethod), err, 0, "")
 	}
-	log.Log.Log(log.Debug, "got response with code: %d", resp.StatusCode)
-	return resp, nil
+	log.Record(log.Debug, "got response", log.F("method", apiMethod), log.F("statusCode", resp.StatusCode))
+
+	return newRequestResult(resp, params.Get(sessionKey))
+}
+
+//newRequestResult buffers the response body, so the status can be decoded while the body stays readable for the caller
+func newRequestResult(resp *http.Response, usedSessionKey string) (*common.RequestResult, error) {
+	res := &common.RequestResult{
+		Response:   resp,
+		SessionKey: usedSessionKey,
+	}
+	if resp.Body == nil {
+		return res, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, common.NewTransportError("failed to read response body", err, resp.StatusCode, "")
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	res.Body = body
+
+	envelope := struct {
+		Status    *common.Status `json:"status"`
+		BulkItems []struct {
+			Status common.StatusBulk `json:"status"`
+		} `json:"requests"`
+	}{}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Status == nil {
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return nil, common.NewTransportError(
+				fmt.Sprintf("unexpected response status code %d", resp.StatusCode),
+				nil,
+				resp.StatusCode,
+				string(body),
+			)
+		}
+		return res, nil
+	}
+
+	res.Status = envelope.Status
+	if len(envelope.BulkItems) > 0 {
+		res.BulkStatuses = make([]common.StatusBulk, 0, len(envelope.BulkItems))
+		for _, bulkItem := range envelope.BulkItems {
+			res.BulkStatuses = append(res.BulkStatuses, bulkItem.Status)
+		}
+	}
+
+	return res, nil
 }
 
 func (cli *Client) addSessionParams(params url.Values) (url.Values, error) {
@@ -98,6 +176,41 @@ func (cli *Client) addSessionParams(params url.Values) (url.Values, error) {
 	return params, err
 }
 
+//replayOnSessionExpiry renews the session if the API rejected it and repeats the request once
+func (cli *Client) replayOnSessionExpiry(ctx context.Context, call *common.RequestCall, next common.RequestHandler) (*common.RequestResult, error) {
+	res, err := next(ctx, call)
+	if err != nil || res.Status == nil {
+		return res, err
+	}
+	if common.ClassifyApiError(res.Status.ErrorCode) == common.ErrorClassSession {
+		log.Log.Log(log.Debug, "%s failed because of an invalid session, will renew the session and repeat the request", call.MethodName())
+		cli.renewSession(res.SessionKey)
+
+		return next(ctx, call)
+	}
+
+	if errorHandler, ok := cli.sessionProvider.(SessionErrorHandler); ok && errorHandler.HandleError(common.NewFromResponseStatus(res.Status)) {
+		log.Log.Log(log.Debug, "%s failed because the session provider credentials were rejected, will repeat the request", call.MethodName())
+		return next(ctx, call)
+	}
+
+	return res, err
+}
+
+//renewSession invalidates the session only if it's still the rejected one, so concurrent
+//requests which failed with the same session key will trigger only one re-authentication
+func (cli *Client) renewSession(rejectedSessionKey string) {
+	cli.sessionRefreshLock.Lock()
+	defer cli.sessionRefreshLock.Unlock()
+
+	currentSessionKey, err := cli.sessionProvider.GetSession()
+	if err == nil && currentSessionKey != rejectedSessionKey {
+		return
+	}
+
+	cli.sessionProvider.Invalidate()
+}
+
 func (cli *Client) InvalidateSession() {
 	cli.sessionProvider.Invalidate()
 }
@@ -111,6 +224,12 @@ type DestRespWithStatus interface {
 }
 
 func (cli *Client) Scan(ctx context.Context, apiMethod string, filters map[string]string, dest DestRespWithStatus) error {
+	return cli.Call(ctx, apiMethod, filters, dest)
+}
+
+//Call executes the API method and decodes the response into dest, which can be any struct with the response status
+//in the "status" field, e.g. with an embedded common.Status tagged as `json:"status"`
+func (cli *Client) Call(ctx context.Context, apiMethod string, filters map[string]string, dest interface{}) error {
 	resp, err := cli.SendRequest(ctx, apiMethod, filters)
 	if err != nil {
 		return common.NewFromError(apiMethod+" request failed", err, 0)
@@ -121,43 +240,142 @@ func (cli *Client) Scan(ctx context.Context, apiMethod string, filters map[strin
 		return common.NewFromError("unmarshalling of response has failed", err, 0)
 	}
 
-	if err := json.Unmarshal(body, dest); err != nil {
+	status, err := decodeResponse(body, dest)
+	if err != nil {
+		return err
+	}
+
+	return checkResponseStatus(status, resp, body)
+}
+
+//CallBulk executes the inputs as a bulk API call and decodes the response into dest, which can be any struct with the response status
+//in the "status" field and the sub-responses in the "requests" field, if some sub-requests failed a *common.BulkError is returned
+func (cli *Client) CallBulk(ctx context.Context, inputs []BulkInput, filters map[string]string, dest interface{}) error {
+	resp, err := cli.SendRequestBulk(ctx, inputs, filters)
+	if err != nil {
+		return common.NewFromError("bulk request failed", err, 0)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return common.NewFromError("unmarshalling of response has failed", err, 0)
+	}
+
+	status, err := decodeResponse(body, dest)
+	if err != nil {
+		return err
+	}
+
+	if err := checkResponseStatus(status, resp, body); err != nil {
+		return err
+	}
+
+	bulkStatuses := struct {
+		BulkItems []struct {
+			Status common.StatusBulk `json:"status"`
+		} `json:"requests"`
+	}{}
+	if err := json.Unmarshal(body, &bulkStatuses); err != nil {
 		return common.NewFromError("unmarshalling of response failed", err, 0)
 	}
 
-	status := dest.GetStatus()
-	if !IsJSONResponseOK(dest.GetStatus()) {
-		return common.NewErplyErrorf(
-			status.ErrorCode.String(),
-			"request name: %s, error field: %s, response status: %s, body: %s",
-			status.ErrorCode,
-			status.Request,
-			status.ErrorField,
-			status.ResponseStatus,
-			string(body),
-		)
+	return common.NewBulkErrorFromStatuses(len(bulkStatuses.BulkItems), func(i int) common.StatusBulk {
+		return bulkStatuses.BulkItems[i].Status
+	})
+}
+
+//decodeResponse unmarshals the body into dest and gives the status of the response
+func decodeResponse(body []byte, dest interface{}) (*common.Status, error) {
+	if dest != nil {
+		if err := json.Unmarshal(body, dest); err != nil {
+			return nil, common.NewFromError("unmarshalling of response failed", err, 0)
+		}
+	}
+
+	if destWithStatus, ok := dest.(DestRespWithStatus); ok {
+		return destWithStatus.GetStatus(), nil
+	}
+
+	resp := struct {
+		Status common.Status `json:"status"`
+	}{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, common.NewFromError("unmarshalling of response failed", err, 0)
 	}
 
-	return nil
+	return &resp.Status, nil
 }
 
+func checkResponseStatus(status *common.Status, resp *http.Response, body []byte) error {
+	if IsJSONResponseOK(status) {
+		return nil
+	}
+
+	erplyErr := common.NewErplyErrorf(
+		status.ErrorCode.String(),
+		"request name: %s, error field: %s, response status: %s, body: %s",
+		status.ErrorCode,
+		status.Request,
+		status.ErrorField,
+		status.ResponseStatus,
+		string(body),
+	)
+	erplyErr.ErrorField = status.ErrorField
+	erplyErr.Request = status.Request
+	erplyErr.HTTPStatus = resp.StatusCode
+	erplyErr.Body = string(body)
+
+	return erplyErr
+}
+
+//SendRequestBulk executes the inputs as a bulk API call, if there are more than common.MaxBulkRequestsCount inputs
+//they are split into several calls and the responses are merged in the order of inputs
 func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (*http.Response, error) {
-	log.Log.Log(log.Debug, "will call Bulk request with inputs %+v and filters %+v", inputs, filters)
-	bulkRequest := make([]map[string]interface{}, 0, len(inputs))
-	for _, input := range inputs {
+	call := &common.RequestCall{
+		Params:       filters,
+		BulkRequests: inputs,
+	}
+
+	ctx, span := cli.tracer.StartSpan(ctx, common.SpanSendRequestBulk)
+	defer span.End()
+	methodName := call.MethodName()
+	if methodName == "" {
+		methodName = "bulk"
+	}
+	span.SetAttribute(common.AttrMethod, methodName)
+	span.SetAttribute(common.AttrClientCode, cli.clientCode)
+	span.SetAttribute(common.AttrBulkSubRequests, len(inputs))
+
+	var res *common.RequestResult
+	var err error
+	if len(inputs) > common.MaxBulkRequestsCount {
+		res, err = cli.sendChunkedBulk(ctx, inputs, filters)
+	} else {
+		res, err = cli.handle(ctx, call)
+	}
+	common.SetResultAttributes(span, res, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Response, nil
+}
+
+func (cli *Client) doBulkCall(ctx context.Context, call *common.RequestCall) (*common.RequestResult, error) {
+	bulkRequest := make([]map[string]interface{}, 0, len(call.BulkRequests))
+	for _, input := range call.BulkRequests {
 		bulkItemFilters := input.Filters
 		bulkItemFilters["requestName"] = input.MethodName
 
 		bulkRequest = append(bulkRequest, bulkItemFilters)
 	}
+	log.Record(log.Debug, "will call Bulk request", log.F("requests", bulkRequest), log.F("filters", call.Params))
 
 	jsonRequests, err := json.Marshal(bulkRequest)
 	if err != nil {
 		return nil, common.NewFromError("failed to build requests payload", err, 0)
 	}
 
-	filters["requests"] = string(jsonRequests)
-
 	var params url.Values
 	if cli.headersFunc != nil {
 		params = cli.headersFunc("")
@@ -171,7 +389,8 @@ func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filt
 		params = make(url.Values)
 	}
 
-	setParams(params, filters)
+	setParams(params, call.Params)
+	params.Set("requests", string(jsonRequests))
 
 	req, err := getHTTPRequest(cli, strings.NewReader(params.Encode()))
 	if err != nil {
@@ -183,10 +402,11 @@ func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filt
 
 	resp, err := doRequest(req, cli)
 	if err != nil {
-		return nil, common.NewFromError("Bulk request failed", err, 0)
+		return nil, common.NewTransportError("Bulk request failed", err, 0, "")
 	}
-	log.Log.Log(log.Debug, "got response from Bulk API with status %d", resp.StatusCode)
-	return resp, nil
+	log.Record(log.Debug, "got response from Bulk API", log.F("statusCode", resp.StatusCode))
+
+	return newRequestResult(resp, params.Get(sessionKey))
 }
 
 func doRequest(req *http.Request, cli *Client) (*http.Response, error) {
@@ -2,11 +2,19 @@ package common
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -154,3 +162,535 @@ func TestSendRequestBulk(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 	assert.Equal(t, 1, calledTimes)
 }
+
+func TestSendRequestWithInterceptors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("requests") != "" {
+			_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"requests":[{"status":{"requestName":"getSuppliers","responseStatus":"error","errorCode":1011}}]}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{"status":{"request":"getSuppliers","responseStatus":"error","errorCode":1016,"errorField":"supplierID"}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	calls := make([]string, 0)
+	var lastCall *common.RequestCall
+	var lastResult *common.RequestResult
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithInterceptors(
+		func(ctx context.Context, call *common.RequestCall, next common.RequestHandler) (*common.RequestResult, error) {
+			calls = append(calls, "outer")
+			res, err := next(ctx, call)
+			lastCall = call
+			lastResult = res
+			return res, err
+		},
+		func(ctx context.Context, call *common.RequestCall, next common.RequestHandler) (*common.RequestResult, error) {
+			calls = append(calls, "inner")
+			return next(ctx, call)
+		},
+	)
+	cli := constr.Build()
+
+	resp, err := cli.SendRequest(context.Background(), "getSuppliers", map[string]string{"supplierID": "1"})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+	assert.Equal(t, "getSuppliers", lastCall.ApiMethod)
+	assert.False(t, lastCall.IsBulk())
+	assert.Equal(t, map[string]string{"supplierID": "1"}, lastCall.Params)
+	assert.Equal(t, "somesess", lastResult.SessionKey)
+	assert.Equal(t, common.InvalidValue, lastResult.Status.ErrorCode)
+	assert.Equal(t, "supplierID", lastResult.Status.ErrorField)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, lastResult.Body, body)
+
+	_, err = cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{
+			{
+				MethodName: "getSuppliers",
+				Filters:    map[string]interface{}{"supplierID": 2},
+			},
+		},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.True(t, lastCall.IsBulk())
+	assert.Len(t, lastCall.BulkRequests, 1)
+	assert.Equal(t, "ok", lastResult.Status.ResponseStatus)
+	assert.Len(t, lastResult.BulkStatuses, 1)
+	assert.Equal(t, common.InvalidClassifierID, lastResult.BulkStatuses[0].ErrorCode)
+}
+
+type renewableSessionProviderMock struct {
+	lock          sync.Mutex
+	sessionKey    string
+	renewalsCount int
+}
+
+func (sp *renewableSessionProviderMock) GetSession() (sessionKey string, err error) {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	if sp.sessionKey == "" {
+		sp.renewalsCount++
+		sp.sessionKey = fmt.Sprintf("sess%d", sp.renewalsCount)
+	}
+
+	return sp.sessionKey, nil
+}
+
+func (sp *renewableSessionProviderMock) Invalidate() {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	sp.sessionKey = ""
+}
+
+func TestSessionRenewalOnExpiredSession(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		if r.FormValue("sessionKey") == "sess1" {
+			_, err = w.Write([]byte(`{"status":{"request":"getSuppliers","responseStatus":"ok"}}`))
+		} else {
+			_, err = w.Write([]byte(`{"status":{"request":"getSuppliers","responseStatus":"error","errorCode":1054}}`))
+		}
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	sessionProvider := &renewableSessionProviderMock{sessionKey: "expiredSess"}
+	constr := &ClientConstructor{}
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessionProvider)
+	cli := constr.Build()
+
+	const concurrentRequestsCount = 50
+	wg := sync.WaitGroup{}
+	wg.Add(concurrentRequestsCount)
+	for i := 0; i < concurrentRequestsCount; i++ {
+		go func() {
+			defer wg.Done()
+			res, err := cli.handle(context.Background(), &common.RequestCall{ApiMethod: "getSuppliers"})
+			assert.NoError(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, "ok", res.Status.ResponseStatus)
+			assert.Equal(t, "sess1", res.SessionKey)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, sessionProvider.renewalsCount)
+}
+
+func TestNoSessionRenewalOnJWTErrors(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+		_, err := w.Write([]byte(`{"status":{"request":"getSuppliers","responseStatus":"error","errorCode":1194}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	sessionProvider := &renewableSessionProviderMock{sessionKey: "sess"}
+	constr := &ClientConstructor{}
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessionProvider)
+	cli := constr.Build()
+
+	res, err := cli.handle(context.Background(), &common.RequestCall{ApiMethod: "getSuppliers"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.JWTExpired, res.Status.ErrorCode)
+
+	//the session key provider doesn't handle the JWT errors, so its session is kept
+	assert.Equal(t, 1, calledTimes)
+	assert.Equal(t, 0, sessionProvider.renewalsCount)
+	assert.Equal(t, "sess", res.SessionKey)
+}
+
+func TestNoSessionRenewalForStaticSessionKey(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+		_, err := w.Write([]byte(`{"status":{"request":"getSuppliers","responseStatus":"error","errorCode":1055}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cli := NewClientWithURL("somesess", "someclient", "", srv.URL, nil, nil)
+	_, err := cli.SendRequest(context.Background(), "getSuppliers", map[string]string{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, calledTimes)
+	sessionKey, err := cli.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, "somesess", sessionKey)
+}
+
+func TestSendRequestWithUnexpectedResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, err := w.Write([]byte("<html>Bad Gateway</html>"))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cli := NewClientWithURL("somesess", "someclient", "", srv.URL, nil, nil)
+	_, err := cli.SendRequest(context.Background(), "getSuppliers", map[string]string{})
+	assert.Error(t, err)
+	if err == nil {
+		return
+	}
+
+	assert.True(t, errors.Is(err, common.ErrTransport))
+	erplyErr := err.(*common.ErplyError)
+	assert.Equal(t, http.StatusBadGateway, erplyErr.HTTPStatus)
+	assert.Equal(t, "<html>Bad Gateway</html>", erplyErr.Body)
+}
+
+func TestSendRequestBulkWithChunking(t *testing.T) {
+	lock := sync.Mutex{}
+	calledTimes := 0
+	running := 0
+	maxRunning := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		calledTimes++
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+
+		defer func() {
+			lock.Lock()
+			running--
+			lock.Unlock()
+		}()
+		time.Sleep(time.Millisecond * 10)
+
+		filters, err := ExtractBulkFiltersFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "someValue", filters["someKey"])
+
+		requests := filters["requests"].([]map[string]interface{})
+		assert.True(t, len(requests) <= common.MaxBulkRequestsCount)
+
+		//the chunk with the item 150 is rejected as a whole
+		for _, request := range requests {
+			if request["requestID"] == "150" {
+				_, err = fmt.Fprint(w, `{"status":{"request":"","responseStatus":"error","errorCode":1020}}`)
+				assert.NoError(t, err)
+				return
+			}
+		}
+
+		items := make([]string, 0, len(requests))
+		for _, request := range requests {
+			items = append(items, fmt.Sprintf(
+				`{"status":{"requestName":"saveProduct","requestID":"%s","responseStatus":"ok"},"records":[{"productID":%s}]}`,
+				request["requestID"],
+				request["requestID"],
+			))
+		}
+		_, err = fmt.Fprintf(w, `{"status":{"request":"","responseStatus":"ok"},"requests":[%s]}`, strings.Join(items, ","))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithBulkConcurrency(2)
+	cli := constr.Build()
+
+	inputs := make([]BulkInput, 0, 350)
+	for i := 0; i < 350; i++ {
+		inputs = append(inputs, BulkInput{
+			MethodName: "saveProduct",
+			Filters: map[string]interface{}{
+				"requestID": fmt.Sprint(i),
+			},
+		})
+	}
+
+	resp, err := cli.SendRequestBulk(context.Background(), inputs, map[string]string{"someKey": "someValue"})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Equal(t, 4, calledTimes)
+	assert.Equal(t, 2, maxRunning)
+
+	bulkResp := struct {
+		Status    common.Status `json:"status"`
+		BulkItems []struct {
+			Status  common.StatusBulk `json:"status"`
+			Records []struct {
+				ProductID int `json:"productID"`
+			} `json:"records"`
+		} `json:"requests"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&bulkResp)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "ok", bulkResp.Status.ResponseStatus)
+	assert.Len(t, bulkResp.BulkItems, 350)
+	for i, item := range bulkResp.BulkItems {
+		assert.Equal(t, fmt.Sprint(i), item.Status.RequestID)
+		if i >= 100 && i < 200 {
+			assert.Equal(t, "error", item.Status.ResponseStatus)
+			assert.Equal(t, common.TooManyBulkSubRequests, item.Status.ErrorCode)
+			assert.Len(t, item.Records, 0)
+			continue
+		}
+		assert.Equal(t, "ok", item.Status.ResponseStatus)
+		assert.Equal(t, i, item.Records[0].ProductID)
+	}
+}
+
+func TestSendRequestBulkWithFailedChunk(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filters, err := ExtractBulkFiltersFromRequest(r)
+		assert.NoError(t, err)
+
+		requests := filters["requests"].([]map[string]interface{})
+		if requests[0]["requestID"] == "100" {
+			w.WriteHeader(http.StatusBadGateway)
+			_, err = w.Write([]byte("<html>Bad Gateway</html>"))
+			assert.NoError(t, err)
+			return
+		}
+
+		items := make([]string, 0, len(requests))
+		for _, request := range requests {
+			items = append(items, fmt.Sprintf(
+				`{"status":{"requestName":"saveProduct","requestID":"%s","responseStatus":"ok"}}`,
+				request["requestID"],
+			))
+		}
+		_, err = fmt.Fprintf(w, `{"status":{"request":"","responseStatus":"ok"},"requests":[%s]}`, strings.Join(items, ","))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithBulkConcurrency(3)
+	cli := constr.Build()
+
+	inputs := make([]BulkInput, 0, 250)
+	for i := 0; i < 250; i++ {
+		inputs = append(inputs, BulkInput{
+			MethodName: "saveProduct",
+			Filters: map[string]interface{}{
+				"requestID": fmt.Sprint(i),
+			},
+		})
+	}
+
+	resp, err := cli.SendRequestBulk(context.Background(), inputs, map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	bulkResp := struct {
+		Status    common.Status `json:"status"`
+		BulkItems []struct {
+			Status common.StatusBulk `json:"status"`
+		} `json:"requests"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&bulkResp)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "ok", bulkResp.Status.ResponseStatus)
+	assert.Len(t, bulkResp.BulkItems, 250)
+
+	bulkErr := &common.BulkError{}
+	for i, item := range bulkResp.BulkItems {
+		assert.Equal(t, fmt.Sprint(i), item.Status.RequestID)
+		bulkErr.Add(i, item.Status)
+	}
+	assert.Equal(t, expectedRange(100, 200), bulkErr.Failed())
+
+	//the items which were never answered keep the error of their request
+	assert.Contains(t, bulkErr.ItemError(100).SendError, "unexpected response status code 502")
+	assert.True(t, errors.Is(bulkErr, common.ErrTransport))
+}
+
+func TestSendRequestBulkWithAllChunksFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, err := w.Write([]byte("<html>Bad Gateway</html>"))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cli := NewClientWithURL("somesess", "someclient", "", srv.URL, nil, nil)
+
+	inputs := make([]BulkInput, 0, 150)
+	for i := 0; i < 150; i++ {
+		inputs = append(inputs, BulkInput{MethodName: "saveProduct", Filters: map[string]interface{}{}})
+	}
+
+	_, err := cli.SendRequestBulk(context.Background(), inputs, map[string]string{})
+	assert.True(t, errors.Is(err, common.ErrTransport))
+}
+
+func expectedRange(from, to int) []int {
+	indexes := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		indexes = append(indexes, i)
+	}
+
+	return indexes
+}
+
+type attributesSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (as *attributesSpan) SetAttribute(key string, value interface{}) {
+	as.attributes[key] = value
+}
+
+func (as *attributesSpan) RecordError(err error) {
+	as.err = err
+}
+
+func (as *attributesSpan) End() {
+	as.ended = true
+}
+
+type attributesTracer struct {
+	spans []*attributesSpan
+}
+
+func (at *attributesTracer) StartSpan(ctx context.Context, name string) (context.Context, common.Span) {
+	span := &attributesSpan{name: name, attributes: map[string]interface{}{}}
+	at.spans = append(at.spans, span)
+	return ctx, span
+}
+
+func TestSendRequestTracing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("request") == "getProducts" {
+			_, _ = w.Write([]byte(`{"status":{"responseStatus":"error","errorCode":1016}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":{"responseStatus":"ok"},"requests":[{"status":{"responseStatus":"ok"}},{"status":{"responseStatus":"ok"}}]}`))
+	}))
+	defer srv.Close()
+
+	tracer := &attributesTracer{}
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithTracer(tracer)
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+
+	_, err = cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{
+			{MethodName: "getSuppliers", Filters: map[string]interface{}{}},
+			{MethodName: "getCustomers", Filters: map[string]interface{}{}},
+		},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+
+	assert.Len(t, tracer.spans, 2)
+	assert.Equal(t, common.SpanSendRequest, tracer.spans[0].name)
+	assert.True(t, tracer.spans[0].ended)
+	assert.Equal(t, map[string]interface{}{
+		common.AttrMethod:         "getProducts",
+		common.AttrClientCode:     "someclient",
+		common.AttrHTTPStatusCode: 200,
+		common.AttrErrorCode:      1016,
+	}, tracer.spans[0].attributes)
+
+	assert.Equal(t, common.SpanSendRequestBulk, tracer.spans[1].name)
+	assert.Equal(t, map[string]interface{}{
+		common.AttrMethod:          "bulk",
+		common.AttrClientCode:      "someclient",
+		common.AttrBulkSubRequests: 2,
+		common.AttrHTTPStatusCode:  200,
+	}, tracer.spans[1].attributes)
+}
+
+type recordsLogger struct {
+	lock    sync.Mutex
+	records []string
+}
+
+func (rl *recordsLogger) Log(t log.Type, message string, arguments ...interface{}) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	rl.records = append(rl.records, fmt.Sprintf(message, arguments...))
+}
+
+func TestSendRequestLogsWithoutCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":{"responseStatus":"ok"}}`))
+	}))
+	defer srv.Close()
+
+	logger := &recordsLogger{}
+	oldLogger := log.Log
+	log.Log = logger
+	defer func() {
+		log.Log = oldLogger
+	}()
+
+	cli := NewClientWithURL("somesess", "someclient", "somepartner", srv.URL, nil, nil)
+
+	_, err := cli.SendRequest(context.Background(), "verifyUserFull", map[string]string{"username": "john", "password": "somepass"})
+	assert.NoError(t, err)
+
+	_, err = cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{{MethodName: "verifyUser", Filters: map[string]interface{}{"password": "somepass"}}},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, logger.records)
+	for _, record := range logger.records {
+		assert.NotContains(t, record, "somesess")
+		assert.NotContains(t, record, "somepass")
+		assert.NotContains(t, record, "somepartner")
+	}
+}
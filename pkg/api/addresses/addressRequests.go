@@ -59,10 +59,10 @@ func (cli *Client) GetAddressesBulk(ctx context.Context, bulkFilters []map[strin
 		return addrResp, sharedCommon.NewErplyError(addrResp.Status.ErrorCode.String(), addrResp.Status.Request+": "+addrResp.Status.ResponseStatus, addrResp.Status.ErrorCode)
 	}
 
-	for _, addrBulkItem := range addrResp.BulkItems {
-		if !common.IsJSONResponseOK(&addrBulkItem.Status.Status) {
-			return addrResp, sharedCommon.NewErplyError(addrBulkItem.Status.ErrorCode.String(), addrBulkItem.Status.Request+": "+addrBulkItem.Status.ResponseStatus, addrResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(addrResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return addrResp.BulkItems[i].Status
+	}); err != nil {
+		return addrResp, err
 	}
 
 	return addrResp, nil
@@ -115,10 +115,6 @@ func (cli *Client) DeleteAddressBulk(
 ) (DeleteAddressResponseBulk, error) {
 	var bulkResp DeleteAddressResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d addresses in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -145,14 +141,10 @@ func (cli *Client) DeleteAddressBulk(
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -161,10 +153,6 @@ func (cli *Client) DeleteAddressBulk(
 func (cli *Client) SaveAddressesBulk(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (SaveAddressesResponseBulk, error) {
 	var saveAddressesResponseBulk SaveAddressesResponseBulk
 
-	if len(addrMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveAddressesResponseBulk, fmt.Errorf("cannot save more than %d addresses in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(addrMap))
 	for _, addr := range addrMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -195,14 +183,10 @@ func (cli *Client) SaveAddressesBulk(ctx context.Context, addrMap []map[string]i
 		)
 	}
 
-	for _, addrBulkItem := range saveAddressesResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&addrBulkItem.Status.Status) {
-			return saveAddressesResponseBulk, sharedCommon.NewErplyError(
-				addrBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", addrBulkItem.Status),
-				saveAddressesResponseBulk.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(saveAddressesResponseBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return saveAddressesResponseBulk.BulkItems[i].Status
+	}); err != nil {
+		return saveAddressesResponseBulk, err
 	}
 
 	return saveAddressesResponseBulk, nil
@@ -0,0 +1,59 @@
+package addresses
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//AddressesIterator gives the addresses of a listing one by one
+type AddressesIterator struct {
+	*sharedCommon.ItemsIterator
+	value sharedCommon.Address
+}
+
+//IterateAddresses lists the addresses matching the filters with AddressListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateAddresses(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *AddressesIterator {
+	lister := sharedCommon.NewLister(settings, NewAddressListingDataProvider(erplyClient), time.Sleep)
+	return NewAddressesIterator(ctx, lister, filters)
+}
+
+//NewAddressesIterator iterates over the items of a lister which was created with NewAddressListingDataProvider
+func NewAddressesIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *AddressesIterator {
+	return &AddressesIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *AddressesIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(sharedCommon.Address)
+	if !ok {
+		it.FailUnexpectedPayload("sharedCommon.Address")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *AddressesIterator) Value() sharedCommon.Address {
+	return it.value
+}
+
+//ForEachAddress calls f for every item of IterateAddresses, the iteration stops at the first error of the listing or f
+func ForEachAddress(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(address sharedCommon.Address) error) error {
+	it := IterateAddresses(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
@@ -0,0 +1,26 @@
+package addresses
+
+import (
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//AddressesKeyset pages the addresses by their lastModified time with the changedSince filter, see Lister.SetKeysetPagination
+func AddressesKeyset() *sharedCommon.KeysetPagination {
+	return &sharedCommon.KeysetPagination{
+		KeyFilter:    "changedSince",
+		OrderFilters: map[string]interface{}{"orderBy": "changed", "orderByDir": "asc"},
+		ItemKey: func(item interface{}) (int64, int, bool) {
+			address, ok := item.(sharedCommon.Address)
+			return address.LastModified.LastModified, address.AddressID, ok
+		},
+	}
+}
+
+//NewAddressesKeysetLister creates a lister of NewAddressListingDataProvider which uses AddressesKeyset instead of the offset pages
+func NewAddressesKeysetLister(erplyClient Manager, settings sharedCommon.ListingSettings) *sharedCommon.Lister {
+	lister := sharedCommon.NewLister(settings, NewAddressListingDataProvider(erplyClient), time.Sleep)
+	lister.SetKeysetPagination(AddressesKeyset())
+	return lister
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//JWTClaims are the claims of a token which are used by the client, the signature is not verified
+type JWTClaims struct {
+	//ExpiresAt is zero if the token has no exp claim
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+	//ClientCode is the account the token was issued for, it's read from the clientCode or customerCode claim
+	//either on the top level or in the data claim
+	ClientCode string
+	//Raw contains all claims of the token
+	Raw map[string]interface{}
+}
+
+//IsExpired tells if the token expires before the given time, tokens without the exp claim never expire
+func (c *JWTClaims) IsExpired(at time.Time) bool {
+	return !c.ExpiresAt.IsZero() && !c.ExpiresAt.After(at)
+}
+
+//DecodeJWTClaims reads the claims of the token locally without verifying its signature,
+//malformed tokens give an *sharedCommon.ErplyError with the JWTDecodingFailure code
+func DecodeJWTClaims(token string) (*JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, jwtDecodingError(fmt.Errorf("token has %d parts instead of 3", len(parts)))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, jwtDecodingError(err)
+	}
+
+	raw := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(string(payload)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, jwtDecodingError(err)
+	}
+
+	claims := &JWTClaims{Raw: raw}
+	if claims.ExpiresAt, err = unixClaim(raw, "exp"); err != nil {
+		return nil, jwtDecodingError(err)
+	}
+	if claims.IssuedAt, err = unixClaim(raw, "iat"); err != nil {
+		return nil, jwtDecodingError(err)
+	}
+
+	claims.ClientCode = accountClaim(raw)
+	if claims.ClientCode == "" {
+		if data, ok := raw["data"].(map[string]interface{}); ok {
+			claims.ClientCode = accountClaim(data)
+		}
+	}
+
+	return claims, nil
+}
+
+//IsJWTRefreshError tells if the API rejected the token in a way which a new token can fix
+func IsJWTRefreshError(err error) bool {
+	var erplyErr *sharedCommon.ErplyError
+	if !errors.As(err, &erplyErr) {
+		return false
+	}
+
+	switch erplyErr.Code {
+	case sharedCommon.JWTExpired, sharedCommon.JWTDecodingFailure, sharedCommon.WrongJWTAccount:
+		return true
+	default:
+		return false
+	}
+}
+
+func jwtDecodingError(err error) error {
+	return sharedCommon.NewFromError("failed to decode the JWT", err, sharedCommon.JWTDecodingFailure)
+}
+
+func unixClaim(claims map[string]interface{}, name string) (time.Time, error) {
+	value, ok := claims[name]
+	if !ok || value == nil {
+		return time.Time{}, nil
+	}
+
+	var seconds float64
+	var err error
+	switch v := value.(type) {
+	case json.Number:
+		seconds, err = v.Float64()
+	case string:
+		seconds, err = strconv.ParseFloat(v, 64)
+	default:
+		err = fmt.Errorf("unexpected type %T", value)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s claim: %v", name, err)
+	}
+
+	return time.Unix(int64(seconds), 0).UTC(), nil
+}
+
+func accountClaim(claims map[string]interface{}) string {
+	for _, name := range []string{"clientCode", "customerCode"} {
+		switch v := claims[name].(type) {
+		case string:
+			if v != "" {
+				return v
+			}
+		case json.Number:
+			return v.String()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestJWT(payload string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + encode([]byte(payload)) + ".signature"
+}
+
+func TestDecodeJWTClaims(t *testing.T) {
+	claims, err := DecodeJWTClaims(newTestJWT(`{"iat":1600000000,"exp":1600003600,"clientCode":"123"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1600003600, 0).UTC(), claims.ExpiresAt)
+	assert.Equal(t, time.Unix(1600000000, 0).UTC(), claims.IssuedAt)
+	assert.Equal(t, "123", claims.ClientCode)
+	assert.True(t, claims.IsExpired(time.Unix(1600003600, 0)))
+	assert.False(t, claims.IsExpired(time.Unix(1600003599, 0)))
+
+	claims, err = DecodeJWTClaims(newTestJWT(`{"exp":"1600003600","data":{"customerCode":456}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1600003600, 0).UTC(), claims.ExpiresAt)
+	assert.Equal(t, "456", claims.ClientCode)
+
+	claims, err = DecodeJWTClaims(newTestJWT(`{"sub":"john"}`))
+	assert.NoError(t, err)
+	assert.True(t, claims.ExpiresAt.IsZero())
+	assert.False(t, claims.IsExpired(time.Now()))
+	assert.Equal(t, "john", claims.Raw["sub"])
+
+	for _, token := range []string{"", "abc.def", "a.%%%.c", newTestJWT(`[1]`), newTestJWT(`{"exp":true}`)} {
+		_, err = DecodeJWTClaims(token)
+		assert.True(t, IsJWTRefreshError(err), token)
+
+		var erplyErr *sharedCommon.ErplyError
+		assert.True(t, errors.As(err, &erplyErr), token)
+		assert.Equal(t, sharedCommon.JWTDecodingFailure, erplyErr.Code)
+	}
+}
+
+func TestIsJWTRefreshError(t *testing.T) {
+	for _, code := range []sharedCommon.ApiError{sharedCommon.JWTExpired, sharedCommon.JWTDecodingFailure, sharedCommon.WrongJWTAccount} {
+		assert.True(t, IsJWTRefreshError(sharedCommon.NewErplyError("Error", "failed", code)), code.String())
+	}
+
+	assert.False(t, IsJWTRefreshError(sharedCommon.NewErplyError("Error", "failed", sharedCommon.LoginFailed)))
+	assert.False(t, IsJWTRefreshError(errors.New("some error")))
+	assert.False(t, IsJWTRefreshError(nil))
+}
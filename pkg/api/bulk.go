@@ -0,0 +1,344 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/company"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+	"github.com/erply/api-go-wrapper/pkg/api/pos"
+	"github.com/erply/api-go-wrapper/pkg/api/prices"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+	"github.com/erply/api-go-wrapper/pkg/api/warehouse"
+)
+
+//BulkBuilder queues calls of different API methods and sends them in one bulk request,
+//so e.g. reference data for a POS can be loaded with one request of the quota
+type BulkBuilder struct {
+	cli   *common.Client
+	calls []*BulkCall
+}
+
+//BulkCall is a queued sub-request of a BulkBuilder, its result is available after BulkBuilder.Execute
+type BulkCall struct {
+	//Index is the position of the sub-request in the bulk request
+	Index      int
+	MethodName string
+	Filters    map[string]interface{}
+	//Status is the status of the sub-request response
+	Status   sharedCommon.StatusBulk
+	records  interface{}
+	err      error
+	executed bool
+}
+
+//Err gives the failure of the sub-request or nil if it succeeded
+func (c *BulkCall) Err() error {
+	if !c.executed {
+		return fmt.Errorf("bulk call %s is not executed yet", c.MethodName)
+	}
+
+	return c.err
+}
+
+//Done tells if the sub-request was executed
+func (c *BulkCall) Done() bool {
+	return c.executed
+}
+
+//NewBulk creates a builder for a bulk request with calls of different API methods
+func (c *Client) NewBulk() *BulkBuilder {
+	return &BulkBuilder{cli: c.commonClient}
+}
+
+//Add queues a call of the API method, after execution the "records" part of its response will be decoded into records,
+//which should be a pointer, e.g. to a slice of models, or nil if the records are not needed
+func (b *BulkBuilder) Add(methodName string, filters map[string]interface{}, records interface{}) *BulkCall {
+	if filters == nil {
+		filters = map[string]interface{}{}
+	}
+	call := &BulkCall{
+		Index:      len(b.calls),
+		MethodName: methodName,
+		Filters:    filters,
+		records:    records,
+	}
+	b.calls = append(b.calls, call)
+
+	return call
+}
+
+//Len gives the amount of queued calls
+func (b *BulkBuilder) Len() int {
+	return len(b.calls)
+}
+
+//Execute sends all queued calls in one bulk request and fills their results,
+//if some of the calls failed, the others still get their results and a *sharedCommon.BulkError is returned
+func (b *BulkBuilder) Execute(ctx context.Context, baseFilters map[string]string) error {
+	if len(b.calls) == 0 {
+		return nil
+	}
+
+	bulkInputs := make([]common.BulkInput, 0, len(b.calls))
+	for _, call := range b.calls {
+		bulkInputs = append(bulkInputs, common.BulkInput{
+			MethodName: call.MethodName,
+			Filters:    call.Filters,
+		})
+	}
+
+	resp, err := b.cli.SendRequestBulk(ctx, bulkInputs, baseFilters)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	bulkResp := struct {
+		Status    sharedCommon.Status `json:"status"`
+		BulkItems []json.RawMessage   `json:"requests"`
+	}{}
+	if err := json.Unmarshal(body, &bulkResp); err != nil {
+		return fmt.Errorf("ERPLY API: failed to unmarshal bulk response from '%s': %v", string(body), err)
+	}
+	if !common.IsJSONResponseOK(&bulkResp.Status) {
+		return sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+	}
+	if len(bulkResp.BulkItems) != len(b.calls) {
+		return fmt.Errorf("ERPLY API: expected %d bulk responses but got %d", len(b.calls), len(bulkResp.BulkItems))
+	}
+
+	bulkErr := &sharedCommon.BulkError{}
+	for i, call := range b.calls {
+		call.executed = true
+		call.err = nil
+
+		bulkItem := struct {
+			Status  sharedCommon.StatusBulk `json:"status"`
+			Records json.RawMessage         `json:"records"`
+		}{}
+		if err := json.Unmarshal(bulkResp.BulkItems[i], &bulkItem); err != nil {
+			call.err = fmt.Errorf("ERPLY API: failed to unmarshal %s response from '%s': %v", call.MethodName, string(bulkResp.BulkItems[i]), err)
+			continue
+		}
+
+		call.Status = bulkItem.Status
+		if call.Status.RequestName == "" {
+			call.Status.RequestName = call.MethodName
+		}
+		bulkErr.Add(i, call.Status)
+		if !common.IsJSONResponseOK(&call.Status.Status) {
+			call.err = bulkErr.ItemError(i).Err()
+			continue
+		}
+
+		if call.records == nil || len(bulkItem.Records) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(bulkItem.Records, call.records); err != nil {
+			call.err = fmt.Errorf("ERPLY API: failed to unmarshal %s records from '%s': %v", call.MethodName, string(bulkItem.Records), err)
+		}
+	}
+
+	if bulkErr.HasFailures() {
+		return bulkErr
+	}
+	for _, call := range b.calls {
+		if call.err != nil {
+			return call.err
+		}
+	}
+
+	return nil
+}
+
+//ProductsBulkCall is a queued getProducts call
+type ProductsBulkCall struct {
+	*BulkCall
+	Products []products.Product
+}
+
+//GetProducts queues a getProducts call
+func (b *BulkBuilder) GetProducts(filters map[string]interface{}) *ProductsBulkCall {
+	res := &ProductsBulkCall{}
+	res.BulkCall = b.Add("getProducts", filters, &res.Products)
+	return res
+}
+
+//ProductGroupsBulkCall is a queued getProductGroups call
+type ProductGroupsBulkCall struct {
+	*BulkCall
+	ProductGroups []products.ProductGroup
+}
+
+//GetProductGroups queues a getProductGroups call
+func (b *BulkBuilder) GetProductGroups(filters map[string]interface{}) *ProductGroupsBulkCall {
+	res := &ProductGroupsBulkCall{}
+	res.BulkCall = b.Add("getProductGroups", filters, &res.ProductGroups)
+	return res
+}
+
+//ProductCategoriesBulkCall is a queued getProductCategories call
+type ProductCategoriesBulkCall struct {
+	*BulkCall
+	ProductCategories []products.ProductCategory
+}
+
+//GetProductCategories queues a getProductCategories call
+func (b *BulkBuilder) GetProductCategories(filters map[string]interface{}) *ProductCategoriesBulkCall {
+	res := &ProductCategoriesBulkCall{}
+	res.BulkCall = b.Add("getProductCategories", filters, &res.ProductCategories)
+	return res
+}
+
+//ProductUnitsBulkCall is a queued getProductUnits call
+type ProductUnitsBulkCall struct {
+	*BulkCall
+	ProductUnits []products.ProductUnit
+}
+
+//GetProductUnits queues a getProductUnits call
+func (b *BulkBuilder) GetProductUnits(filters map[string]interface{}) *ProductUnitsBulkCall {
+	res := &ProductUnitsBulkCall{}
+	res.BulkCall = b.Add("getProductUnits", filters, &res.ProductUnits)
+	return res
+}
+
+//CustomersBulkCall is a queued getCustomers call
+type CustomersBulkCall struct {
+	*BulkCall
+	Customers []customers.Customer
+}
+
+//GetCustomers queues a getCustomers call
+func (b *BulkBuilder) GetCustomers(filters map[string]interface{}) *CustomersBulkCall {
+	res := &CustomersBulkCall{}
+	res.BulkCall = b.Add("getCustomers", filters, &res.Customers)
+	return res
+}
+
+//SuppliersBulkCall is a queued getSuppliers call
+type SuppliersBulkCall struct {
+	*BulkCall
+	Suppliers []customers.Supplier
+}
+
+//GetSuppliers queues a getSuppliers call
+func (b *BulkBuilder) GetSuppliers(filters map[string]interface{}) *SuppliersBulkCall {
+	res := &SuppliersBulkCall{}
+	res.BulkCall = b.Add("getSuppliers", filters, &res.Suppliers)
+	return res
+}
+
+//VatRatesBulkCall is a queued getVatRates call
+type VatRatesBulkCall struct {
+	*BulkCall
+	VatRates []sales.VatRate
+}
+
+//GetVatRates queues a getVatRates call
+func (b *BulkBuilder) GetVatRates(filters map[string]interface{}) *VatRatesBulkCall {
+	res := &VatRatesBulkCall{}
+	res.BulkCall = b.Add("getVatRates", filters, &res.VatRates)
+	return res
+}
+
+//WarehousesBulkCall is a queued getWarehouses call
+type WarehousesBulkCall struct {
+	*BulkCall
+	Warehouses []warehouse.Warehouse
+}
+
+//GetWarehouses queues a getWarehouses call
+func (b *BulkBuilder) GetWarehouses(filters map[string]interface{}) *WarehousesBulkCall {
+	res := &WarehousesBulkCall{}
+	res.BulkCall = b.Add("getWarehouses", filters, &res.Warehouses)
+	return res
+}
+
+//PriceListsBulkCall is a queued getPriceLists call
+type PriceListsBulkCall struct {
+	*BulkCall
+	PriceLists []prices.PriceList
+}
+
+//GetPriceLists queues a getPriceLists call
+func (b *BulkBuilder) GetPriceLists(filters map[string]interface{}) *PriceListsBulkCall {
+	res := &PriceListsBulkCall{}
+	res.BulkCall = b.Add("getPriceLists", filters, &res.PriceLists)
+	return res
+}
+
+//PointsOfSaleBulkCall is a queued getPointsOfSale call
+type PointsOfSaleBulkCall struct {
+	*BulkCall
+	PointsOfSale []pos.PointOfSale
+}
+
+//GetPointsOfSale queues a getPointsOfSale call
+func (b *BulkBuilder) GetPointsOfSale(filters map[string]interface{}) *PointsOfSaleBulkCall {
+	res := &PointsOfSaleBulkCall{}
+	res.BulkCall = b.Add("getPointsOfSale", filters, &res.PointsOfSale)
+	return res
+}
+
+//ConfParametersBulkCall is a queued getConfParameters call
+type ConfParametersBulkCall struct {
+	*BulkCall
+	ConfParameters []company.ConfParameter
+}
+
+//GetConfParameters queues a getConfParameters call
+func (b *BulkBuilder) GetConfParameters(filters map[string]interface{}) *ConfParametersBulkCall {
+	res := &ConfParametersBulkCall{}
+	res.BulkCall = b.Add("getConfParameters", filters, &res.ConfParameters)
+	return res
+}
+
+//CountriesBulkCall is a queued getCountries call
+type CountriesBulkCall struct {
+	*BulkCall
+	Countries []Country
+}
+
+//GetCountries queues a getCountries call
+func (b *BulkBuilder) GetCountries(filters map[string]interface{}) *CountriesBulkCall {
+	res := &CountriesBulkCall{}
+	res.BulkCall = b.Add("getCountries", filters, &res.Countries)
+	return res
+}
+
+//CurrenciesBulkCall is a queued getCurrencies call
+type CurrenciesBulkCall struct {
+	*BulkCall
+	Currencies []Currency
+}
+
+//GetCurrencies queues a getCurrencies call
+func (b *BulkBuilder) GetCurrencies(filters map[string]interface{}) *CurrenciesBulkCall {
+	res := &CurrenciesBulkCall{}
+	res.BulkCall = b.Add("getCurrencies", filters, &res.Currencies)
+	return res
+}
+
+//EmployeesBulkCall is a queued getEmployees call
+type EmployeesBulkCall struct {
+	*BulkCall
+	Employees []Employee
+}
+
+//GetEmployees queues a getEmployees call
+func (b *BulkBuilder) GetEmployees(filters map[string]interface{}) *EmployeesBulkCall {
+	res := &EmployeesBulkCall{}
+	res.BulkCall = b.Add("getEmployees", filters, &res.Employees)
+	return res
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBulkBuilder(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"clientCode": "someclient",
+			"sessionKey": "somesess",
+		})
+
+		common.AssertRequestBulk(t, r, []map[string]interface{}{
+			{
+				"requestName": "getProducts",
+				"productID":   "1",
+			},
+			{
+				"requestName": "getCustomers",
+			},
+			{
+				"requestName": "getVatRates",
+				"active":      "1",
+			},
+			{
+				"requestName": "getWarehouses",
+			},
+			{
+				"requestName": "getCurrencies",
+			},
+		})
+
+		_, err := fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"requests":[
+			{"status":{"requestName":"getProducts","responseStatus":"ok"},"records":[{"productID":1}]},
+			{"status":{"requestName":"getCustomers","responseStatus":"ok"},"records":[{"customerID":2},{"customerID":3}]},
+			{"status":{"requestName":"getVatRates","responseStatus":"ok"},"records":[{"id":"4","rate":"20"}]},
+			{"status":{"requestName":"getWarehouses","responseStatus":"ok"},"records":[{"warehouseID":"5"}]},
+			{"status":{"requestName":"getCurrencies","responseStatus":"error","errorCode":1060,"errorField":"code"}}
+		]}`)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	bulk := c.NewBulk()
+	prods := bulk.GetProducts(map[string]interface{}{"productID": "1"})
+	custs := bulk.GetCustomers(nil)
+	vatRates := bulk.GetVatRates(map[string]interface{}{"active": "1"})
+	warehouses := bulk.GetWarehouses(nil)
+	currencies := bulk.GetCurrencies(nil)
+
+	assert.Equal(t, 5, bulk.Len())
+	assert.False(t, prods.Done())
+	assert.Error(t, prods.Err())
+
+	err := bulk.Execute(context.Background(), nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, calledTimes)
+
+	bulkErr, ok := err.(*sharedCommon.BulkError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, []int{4}, bulkErr.Failed())
+		assert.Equal(t, "getCurrencies", bulkErr.Items[0].RequestName)
+	}
+
+	assert.True(t, prods.Done())
+	assert.NoError(t, prods.Err())
+	assert.Len(t, prods.Products, 1)
+	assert.Equal(t, 1, prods.Products[0].ProductID)
+
+	assert.NoError(t, custs.Err())
+	assert.Len(t, custs.Customers, 2)
+	assert.Equal(t, 3, custs.Customers[1].CustomerID)
+
+	assert.NoError(t, vatRates.Err())
+	assert.Len(t, vatRates.VatRates, 1)
+	assert.Equal(t, "20", vatRates.VatRates[0].Rate)
+
+	assert.NoError(t, warehouses.Err())
+	assert.Len(t, warehouses.Warehouses, 1)
+	assert.Equal(t, "5", warehouses.Warehouses[0].WarehouseID)
+
+	assert.Error(t, currencies.Err())
+	assert.Equal(t, "code", currencies.Status.ErrorField)
+	assert.Len(t, currencies.Currencies, 0)
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"context"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//Call executes any API method, also the ones which are not wrapped by this library yet. It uses the same session handling,
+//headers and request options as the other requests. The response is decoded into dest, which can be any struct
+//with an embedded common.Status tagged as `json:"status"`, a failed response status is returned as *common.ErplyError
+func (c *Client) Call(ctx context.Context, method string, params map[string]string, dest interface{}) error {
+	return c.commonClient.Call(ctx, method, params, dest)
+}
+
+//CallBulk executes any API methods as one bulk request, the response is decoded into dest, which should have the status
+//in the "status" field and the sub-responses in the "requests" field. If some sub-requests failed, dest still gets all
+//results and a *common.BulkError is returned
+func (c *Client) CallBulk(ctx context.Context, requests []sharedCommon.BulkInput, baseParams map[string]string, dest interface{}) error {
+	return c.commonClient.CallBulk(ctx, requests, baseParams, dest)
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type giftCard struct {
+	GiftCardID int    `json:"giftCardID"`
+	Code       string `json:"code"`
+}
+
+type getGiftCardsResponse struct {
+	sharedCommon.Status `json:"status"`
+	GiftCards           []giftCard `json:"records"`
+}
+
+func TestCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"clientCode": "someclient",
+			"sessionKey": "somesess",
+			"request":    "getGiftCards",
+			"code":       "123",
+		})
+
+		_, err := fmt.Fprint(w, `{"status":{"request":"getGiftCards","responseStatus":"ok","recordsTotal":1},"records":[{"giftCardID":1,"code":"123"}]}`)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	resp := getGiftCardsResponse{}
+	err := c.Call(context.Background(), "getGiftCards", map[string]string{"code": "123"}, &resp)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.RecordsTotal)
+	assert.Equal(t, []giftCard{{GiftCardID: 1, Code: "123"}}, resp.GiftCards)
+}
+
+func TestCallFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := fmt.Fprint(w, `{"status":{"request":"getGiftCards","responseStatus":"error","errorCode":1016,"errorField":"code"}}`)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	resp := getGiftCardsResponse{}
+	err := c.Call(context.Background(), "getGiftCards", nil, &resp)
+	assert.Error(t, err)
+
+	var erplyErr *sharedCommon.ErplyError
+	assert.True(t, errors.As(err, &erplyErr))
+	if erplyErr != nil {
+		assert.Equal(t, sharedCommon.InvalidValue, erplyErr.Code)
+		assert.Equal(t, "code", erplyErr.ErrorField)
+	}
+	assert.True(t, errors.Is(err, sharedCommon.ErrValidation))
+}
+
+func TestCallBulk(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertRequestBulk(t, r, []map[string]interface{}{
+			{
+				"requestName": "getGiftCards",
+				"code":        "123",
+			},
+			{
+				"requestName": "getGiftCards",
+				"code":        "",
+			},
+		})
+
+		_, err := fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"requests":[
+			{"status":{"requestName":"getGiftCards","responseStatus":"ok"},"records":[{"giftCardID":1,"code":"123"}]},
+			{"status":{"requestName":"getGiftCards","responseStatus":"error","errorCode":1016,"errorField":"code"},"records":[]}
+		]}`)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	resp := struct {
+		sharedCommon.Status `json:"status"`
+		BulkItems           []struct {
+			Status    sharedCommon.StatusBulk `json:"status"`
+			GiftCards []giftCard              `json:"records"`
+		} `json:"requests"`
+	}{}
+	err := c.CallBulk(
+		context.Background(),
+		[]sharedCommon.BulkInput{
+			{MethodName: "getGiftCards", Filters: map[string]interface{}{"code": "123"}},
+			{MethodName: "getGiftCards", Filters: map[string]interface{}{"code": ""}},
+		},
+		nil,
+		&resp,
+	)
+	assert.Error(t, err)
+
+	bulkErr, ok := err.(*sharedCommon.BulkError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, []int{1}, bulkErr.Failed())
+	}
+
+	assert.Len(t, resp.BulkItems, 2)
+	assert.Equal(t, []giftCard{{GiftCardID: 1, Code: "123"}}, resp.BulkItems[0].GiftCards)
+}
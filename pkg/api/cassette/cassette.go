@@ -0,0 +1,423 @@
+//Package cassette records the HTTP interactions with the ERPLY API to files and replays them in tests without network access.
+//The Recorder is an http.RoundTripper, so it can be used in ClientBuilder.HttpCli or any other HTTP client of this library.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Mode defines if the interactions are recorded or replayed
+type Mode int
+
+const (
+	//ModeReplay answers requests from the cassette only and fails for unknown requests
+	ModeReplay Mode = iota
+	//ModeRecord sends all requests to the API and overwrites the cassette with the new interactions
+	ModeRecord
+	//ModeRecordMissing replays known requests and sends and records only the unknown ones
+	ModeRecordMissing
+)
+
+const redactedValue = "REDACTED"
+
+//ErrInteractionNotFound is returned in the replay mode when the cassette has no matching interaction
+var ErrInteractionNotFound = errors.New("cassette: no recorded interaction matches the request")
+
+//DefaultRedactedParams are the parameters which are never written to cassettes
+var DefaultRedactedParams = []string{"sessionKey", "password", "partnerKey"}
+
+//Settings of the Recorder
+type Settings struct {
+	Mode Mode
+	//RedactedParams are replaced in the recorded parameters and in the JSON fields of the response bodies, DefaultRedactedParams if empty
+	RedactedParams []string
+	//IgnoredParams are not used for matching of requests, e.g. parameters with timestamps
+	IgnoredParams []string
+	//Transport sends the requests to the API in the record modes, http.DefaultTransport if nil
+	Transport http.RoundTripper
+}
+
+//Request is a recorded request
+type Request struct {
+	//Method is the API method name, for bulk requests it's a comma separated list of the sub-request names
+	Method string            `json:"method"`
+	Params map[string]string `json:"params"`
+}
+
+//Response is a recorded response
+type Response struct {
+	StatusCode int                 `json:"statusCode"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body"`
+}
+
+//Interaction is a recorded request with its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+//Recorder is an http.RoundTripper which records or replays interactions from a cassette file
+type Recorder struct {
+	path           string
+	settings       Settings
+	redacted       map[string]bool
+	ignored        map[string]bool
+	bodyRedactions []*regexp.Regexp
+	lock           sync.Mutex
+	interactions   []Interaction
+	//replayed counts how many times each interaction key was used, so repeated requests get their responses in the recorded order
+	replayed map[string]int
+}
+
+//New creates a recorder for the cassette file, in the replay modes the file is loaded if it exists
+func New(path string, settings Settings) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		settings: settings,
+		redacted: map[string]bool{},
+		ignored:  map[string]bool{},
+		replayed: map[string]int{},
+	}
+
+	redactedParams := settings.RedactedParams
+	if len(redactedParams) == 0 {
+		redactedParams = DefaultRedactedParams
+	}
+	for _, param := range redactedParams {
+		r.redacted[strings.ToLower(param)] = true
+		r.bodyRedactions = append(
+			r.bodyRedactions,
+			regexp.MustCompile(`("`+regexp.QuoteMeta(param)+`"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+		)
+	}
+	for _, param := range settings.IgnoredParams {
+		r.ignored[strings.ToLower(param)] = true
+	}
+
+	if settings.Mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && settings.Mode == ModeRecordMissing {
+			return r, nil
+		}
+		return nil, fmt.Errorf("cassette: failed to read %s: %v", path, err)
+	}
+
+	cf := cassetteFile{}
+	if err := json.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("cassette: failed to decode %s: %v", path, err)
+	}
+	r.interactions = cf.Interactions
+
+	return r, nil
+}
+
+//HTTPClient gives an HTTP client which uses the recorder as transport
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+//Interactions gives a copy of the recorded interactions
+func (r *Recorder) Interactions() []Interaction {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	res := make([]Interaction, len(r.interactions))
+	copy(res, r.interactions)
+	return res
+}
+
+//RoundTrip replays the matching interaction or sends the request and records it depending on the mode
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedReq, outReq, err := r.readRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	key := r.matchKey(recordedReq)
+
+	if r.settings.Mode != ModeRecord {
+		if interaction, ok := r.find(key); ok {
+			closeBody(outReq)
+			return interaction.Response.toHTTPResponse(req), nil
+		}
+		if r.settings.Mode == ModeReplay {
+			closeBody(outReq)
+			return nil, fmt.Errorf("%w: %s", ErrInteractionNotFound, key)
+		}
+	}
+
+	transport := r.settings.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Request: recordedReq,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    map[string][]string{"Content-Type": resp.Header["Content-Type"]},
+			Body:       r.redactBody(string(body)),
+		},
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.interactions = append(r.interactions, interaction)
+	r.replayed[key]++
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) find(key string) (Interaction, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var matching []Interaction
+	for _, interaction := range r.interactions {
+		if r.matchKey(interaction.Request) == key {
+			matching = append(matching, interaction)
+		}
+	}
+	if len(matching) == 0 {
+		return Interaction{}, false
+	}
+
+	//the last response is repeated if the request is sent more times than it was recorded
+	index := r.replayed[key]
+	if index >= len(matching) {
+		index = len(matching) - 1
+	}
+	r.replayed[key]++
+
+	return matching[index], true
+}
+
+//save writes the cassette file, it should be called under the lock
+func (r *Recorder) save() error {
+	data, err := json.MarshalIndent(cassetteFile{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("cassette: failed to create directory for %s: %v", r.path, err)
+	}
+
+	tmpPath := r.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("cassette: failed to write %s: %v", tmpPath, err)
+	}
+
+	return os.Rename(tmpPath, r.path)
+}
+
+//readRequest gives the parameters of the request and the request which should be sent to the transport, the request
+//of the caller isn't changed: its body is read from GetBody, or it's consumed and a clone gets a copy of it
+func (r *Recorder) readRequest(req *http.Request) (Request, *http.Request, error) {
+	values := url.Values{}
+	for key, vals := range req.URL.Query() {
+		values[key] = vals
+	}
+
+	outReq := req
+	if req.Body != nil && req.Body != http.NoBody {
+		var body []byte
+		var err error
+		if req.GetBody != nil {
+			body, err = readBody(req.GetBody())
+		} else {
+			body, err = ioutil.ReadAll(req.Body)
+			req.Body.Close()
+			if err == nil {
+				outReq = req.Clone(req.Context())
+				outReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+		}
+		if err != nil {
+			return Request{}, nil, err
+		}
+
+		bodyValues, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key, vals := range bodyValues {
+				values[key] = vals
+			}
+		}
+	}
+
+	recordedReq := Request{Params: map[string]string{}}
+	for key := range values {
+		value := values.Get(key)
+		if r.redacted[strings.ToLower(key)] {
+			value = redactedValue
+		}
+		recordedReq.Params[key] = value
+	}
+
+	recordedReq.Method = recordedReq.Params["request"]
+	if bulkJSON, ok := recordedReq.Params["requests"]; ok {
+		normalized, names, err := r.normalizeBulk(bulkJSON)
+		if err != nil {
+			return Request{}, nil, fmt.Errorf("cassette: failed to parse bulk requests: %v", err)
+		}
+		recordedReq.Params["requests"] = normalized
+		recordedReq.Method = strings.Join(names, ",")
+	}
+
+	return recordedReq, outReq, nil
+}
+
+func readBody(body io.ReadCloser, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+//closeBody releases the body of a request which isn't sent, the RoundTripper must close it
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+//normalizeBulk redacts the sub-requests and gives them as JSON with sorted keys
+func (r *Recorder) normalizeBulk(bulkJSON string) (string, []string, error) {
+	var requests []map[string]interface{}
+	if err := json.Unmarshal([]byte(bulkJSON), &requests); err != nil {
+		return "", nil, err
+	}
+
+	names := make([]string, 0, len(requests))
+	for _, request := range requests {
+		for key := range request {
+			if r.redacted[strings.ToLower(key)] {
+				request[key] = redactedValue
+			}
+		}
+		names = append(names, fmt.Sprint(request["requestName"]))
+	}
+
+	normalized, err := json.Marshal(requests)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(normalized), names, nil
+}
+
+//matchKey builds a string from the method and the sorted parameters except the ignored and redacted ones
+func (r *Recorder) matchKey(req Request) string {
+	keys := make([]string, 0, len(req.Params))
+	for key := range req.Params {
+		lowerKey := strings.ToLower(key)
+		if r.ignored[lowerKey] || r.redacted[lowerKey] {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, req.Method)
+	for _, key := range keys {
+		value := req.Params[key]
+		if key == "requests" {
+			value = r.stripIgnoredBulkParams(value)
+		}
+		parts = append(parts, key+"="+value)
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func (r *Recorder) stripIgnoredBulkParams(bulkJSON string) string {
+	if len(r.ignored) == 0 {
+		return bulkJSON
+	}
+
+	var requests []map[string]interface{}
+	if err := json.Unmarshal([]byte(bulkJSON), &requests); err != nil {
+		return bulkJSON
+	}
+	for _, request := range requests {
+		for key := range request {
+			if r.ignored[strings.ToLower(key)] {
+				delete(request, key)
+			}
+		}
+	}
+
+	stripped, err := json.Marshal(requests)
+	if err != nil {
+		return bulkJSON
+	}
+
+	return string(stripped)
+}
+
+func (r *Recorder) redactBody(body string) string {
+	for _, re := range r.bodyRedactions {
+		body = re.ReplaceAllString(body, `${1}"`+redactedValue+`"`)
+	}
+
+	return body
+}
+
+func (resp Response) toHTTPResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	for key, values := range resp.Headers {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,153 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/erply/api-go-wrapper/pkg/api"
+	"github.com/erply/api-go-wrapper/pkg/api/erplytest"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(t *testing.T, srv *erplytest.Server, url string, rec *Recorder) *api.Client {
+	cli, err := api.NewClientWithURL(srv.SessionKey, srv.ClientCode, "somePartnerKey", url, rec.HTTPClient(), nil)
+	assert.NoError(t, err)
+	return cli
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := erplytest.NewServer()
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, srv.Products.Add(products.Product{ProductID: i, Code: "code"}))
+	}
+
+	path := filepath.Join(t.TempDir(), "cassettes", "products.json")
+
+	rec, err := New(path, Settings{Mode: ModeRecord, Transport: srv.Client().Transport})
+	assert.NoError(t, err)
+
+	cli := newClient(t, srv, srv.URL, rec)
+	prods, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "2"})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 1)
+
+	bulkResp, err := cli.ProductManager.GetProductsBulk(
+		context.Background(),
+		[]map[string]interface{}{{"pageNo": 1, "recordsOnPage": 2}, {"pageNo": 2, "recordsOnPage": 2}},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, bulkResp.BulkItems, 2)
+	assert.Len(t, rec.Interactions(), 2)
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), srv.SessionKey)
+	assert.NotContains(t, string(data), "somePartnerKey")
+	assert.Contains(t, string(data), redactedValue)
+
+	srv.Close()
+
+	rec, err = New(path, Settings{Mode: ModeReplay})
+	assert.NoError(t, err)
+
+	//the session key is different but redacted params are not matched
+	cli, err = api.NewClientWithURL("otherSession", srv.ClientCode, "", srv.URL, rec.HTTPClient(), nil)
+	assert.NoError(t, err)
+
+	prods, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "2"})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 1)
+	assert.Equal(t, 2, prods[0].ProductID)
+
+	bulkResp, err = cli.ProductManager.GetProductsBulk(
+		context.Background(),
+		[]map[string]interface{}{{"recordsOnPage": 2, "pageNo": 1}, {"recordsOnPage": 2, "pageNo": 2}},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, bulkResp.BulkItems[0].Products, 2)
+	assert.Len(t, bulkResp.BulkItems[1].Products, 1)
+
+	_, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "3"})
+	assert.True(t, errors.Is(err, ErrInteractionNotFound))
+}
+
+func TestRecordMissing(t *testing.T) {
+	srv := erplytest.NewServer()
+	defer srv.Close()
+	assert.NoError(t, srv.Products.Add(products.Product{ProductID: 1}, products.Product{ProductID: 2}))
+
+	path := filepath.Join(t.TempDir(), "products.json")
+	settings := Settings{Mode: ModeRecordMissing, Transport: srv.Client().Transport, IgnoredParams: []string{"requestUnixTime"}}
+
+	rec, err := New(path, settings)
+	assert.NoError(t, err)
+	cli := newClient(t, srv, srv.URL, rec)
+
+	_, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, srv.Calls("getProducts"))
+
+	rec, err = New(path, settings)
+	assert.NoError(t, err)
+	cli = newClient(t, srv, srv.URL, rec)
+
+	prods, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, prods[0].ProductID)
+	assert.Equal(t, 1, srv.Calls("getProducts"))
+
+	prods, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productID": "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, prods[0].ProductID)
+	assert.Equal(t, 2, srv.Calls("getProducts"))
+	assert.Len(t, rec.Interactions(), 2)
+}
+
+func TestRedactBody(t *testing.T) {
+	rec, err := New("unused.json", Settings{Mode: ModeRecord})
+	assert.NoError(t, err)
+
+	body := rec.redactBody(`{"records":[{"sessionKey": "abc\"def","userName":"user"}]}`)
+	assert.Equal(t, `{"records":[{"sessionKey": "REDACTED","userName":"user"}]}`, body)
+}
+
+func TestRoundTripKeepsRequest(t *testing.T) {
+	srv := erplytest.NewServer()
+	defer srv.Close()
+
+	rec, err := New(filepath.Join(t.TempDir(), "requests.json"), Settings{Mode: ModeRecord, Transport: srv.Client().Transport})
+	assert.NoError(t, err)
+
+	params := url.Values{"request": {"getProducts"}, "clientCode": {srv.ClientCode}, "sessionKey": {srv.SessionKey}}
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(params.Encode()))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body := req.Body
+
+	resp, err := rec.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.True(t, body == req.Body)
+	assert.Equal(t, "getProducts", rec.Interactions()[0].Request.Method)
+
+	//the request without GetBody is consumed, but its fields are not changed
+	req, err = http.NewRequest(http.MethodPost, srv.URL, ioutil.NopCloser(strings.NewReader(params.Encode())))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body = req.Body
+
+	resp, err = rec.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.True(t, body == req.Body)
+	assert.Len(t, rec.Interactions(), 2)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -50,6 +51,8 @@ type Client struct {
 	DocumentsManager documents.Manager
 	//Service Discovery
 	ServiceDiscoverer servicediscovery.ServiceDiscoverer
+
+	sessionRefresher *SessionRefresher
 }
 
 func (c *Client) InvalidateSession() {
@@ -60,6 +63,14 @@ func (c *Client) GetSession() (sessionKey string, err error) {
 	return c.commonClient.GetSession()
 }
 
+//Close stops the background session renewal and closes the idle connections of the client
+func (c *Client) Close() {
+	if c.sessionRefresher != nil {
+		c.sessionRefresher.Stop()
+	}
+	c.commonClient.Close()
+}
+
 //SendParametersInRequestBody indicates to the client that the request should add the data payload in the
 //request body instead of using the query parameters. Using the request body eliminates the query size
 //limitations imposed by the maximum URL length
@@ -87,7 +98,6 @@ func NewClientFromCredentials(username, password, clientCode string, customCli *
 	return NewClient(sessionKey, clientCode, customCli)
 }
 
-
 // NewClient Takes three params:
 // sessionKey string obtained from credentials or jwt
 // clientCode erply customer identification number
@@ -139,16 +149,26 @@ func newErplyClient(c *common.Client) *Client {
 }
 
 type ClientBuilder struct {
-	UserName                   string                 //if set this will be used to fetch session key every time when session gets outdated
-	Password                   string                 //if set this will be used to fetch session key every time when session gets outdated
-	ClientCode                 string                 //required value for all requests
-	SessionKey                 string                 //if you don't set SessionProvider this key will be used to auth all requests
-	DefaultSessionLenSeconds   int                    //set the length of dynamically created sessions
-	URL                        string                 //change the base API url
-	PartnerKey                 string                 //set the partner key
-	HttpCli                    *http.Client           //you can adjust the http client transport options here
-	HeadersForEveryRequestFunc common.AuthFunc        //this will set headers for all outgoing requests except for the session key
-	SessionProvider            common.SessionProvider //custom session establishing logic, if not set DynamicSessionProvider is used which requires UserName and Password
+	UserName                   string                              //if set this will be used to fetch session key every time when session gets outdated
+	Password                   string                              //if set this will be used to fetch session key every time when session gets outdated
+	ClientCode                 string                              //required value for all requests
+	SessionKey                 string                              //if you don't set SessionProvider this key will be used to auth all requests
+	DefaultSessionLenSeconds   int                                 //set the length of dynamically created sessions
+	URL                        string                              //change the base API url
+	PartnerKey                 string                              //set the partner key
+	HttpCli                    *http.Client                        //you can adjust the http client transport options here
+	HeadersForEveryRequestFunc common.AuthFunc                     //this will set headers for all outgoing requests except for the session key
+	SessionProvider            common.SessionProvider              //custom session establishing logic, if not set DynamicSessionProvider is used which requires UserName and Password
+	Interceptors               []sharedCommon.Interceptor          //wrap every outgoing request, e.g. for tracing or auditing, the first interceptor is the outermost one
+	RetryPolicy                *sharedCommon.RetryPolicy           //if set, failed requests will be repeated according to this policy, see sharedCommon.DefaultRetryPolicy
+	MethodRetryPolicies        map[string]sharedCommon.RetryPolicy //retry policies per API method name which override RetryPolicy
+	QuotaTracker               *sharedCommon.QuotaTracker          //counts requests against the hourly quota and optionally blocks them before the quota is used up
+	Throttler                  sharedCommon.Throttler              //limits the requests rate of this client e.g. with sharedCommon.NewTokenBucketThrottler, share it with listers to have one budget per account
+	Metrics                    sharedCommon.Metrics                //receives measurements of API calls, retries, throttling and session refreshes e.g. sharedCommon.MetricsRegistry
+	Tracer                     sharedCommon.Tracer                 //opens a span for every API call, use the same tracer in listers to get the API calls as children of the page spans
+	SessionStore               SessionStore                        //persists the session keys of the DynamicSessionProvider e.g. in a file shared by several processes, see NewFileSessionStore
+	SessionRefresh             *SessionRefresherSettings           //if set, the session of the DynamicSessionProvider is renewed in the background before it expires, call Client.Close to stop it
+	BulkConcurrency            int                                 //how many bulk calls can run at once when bulk requests with more than sharedCommon.MaxBulkRequestsCount inputs are split, sharedCommon.DefaultBulkConcurrency by default
 }
 
 type DynamicSessionProvider struct {
@@ -160,11 +180,17 @@ type DynamicSessionProvider struct {
 	DefaultSessionLenSeconds int
 	Lock                     sync.Mutex
 	HTTPClient               *http.Client
+	Metrics                  sharedCommon.Metrics
+	//Store persists the session key, so it's reused after restarts or by other processes instead of a new login
+	Store SessionStore
 }
 
 func (dsp *DynamicSessionProvider) Invalidate() {
 	dsp.Lock.Lock()
 	defer dsp.Lock.Unlock()
+	if dsp.Store != nil && dsp.SessionKey != "" {
+		dsp.deleteStoredSession(dsp.SessionKey)
+	}
 	dsp.SessionKey = ""
 }
 
@@ -177,6 +203,15 @@ func (dsp *DynamicSessionProvider) GetSession() (sessionKey string, err error) {
 		return dsp.SessionKey, nil
 	}
 
+	if dsp.Store != nil {
+		if sessionKey, validTill, ok := dsp.loadStoredSession(); ok {
+			log.Log.Log(log.Debug, "will use the stored session key which is valid till %v", validTill)
+			dsp.SessionKey = sessionKey
+			dsp.SessionValidTill = validTill
+			return dsp.SessionKey, nil
+		}
+	}
+
 	log.Log.Log(log.Debug, "will request new session key since the old one is not valid %v", dsp.SessionValidTill)
 	sessionKey, validTill, err := dsp.getAuthUserFromAPI()
 	if err != nil {
@@ -184,9 +219,15 @@ func (dsp *DynamicSessionProvider) GetSession() (sessionKey string, err error) {
 	}
 
 	log.Log.Log(log.Debug, "got new session key with validity till %v", validTill)
+	if dsp.Metrics != nil {
+		dsp.Metrics.SessionRefreshed()
+	}
 
 	dsp.SessionKey = sessionKey
 	dsp.SessionValidTill = validTill
+	if dsp.Store != nil {
+		dsp.saveStoredSession(sessionKey, validTill)
+	}
 
 	return dsp.SessionKey, nil
 }
@@ -218,7 +259,8 @@ func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, vali
 		dsp.DefaultSessionLenSeconds,
 	)
 
-	req, err := http.NewRequest("POST", requestUrl, nil)
+	//the credentials are sent in the body, so they don't appear in the URL of the request errors which are logged
+	req, err := http.NewRequest("POST", requestUrl, strings.NewReader(params.Encode()))
 	if err != nil {
 		return "", nil, err
 	}
@@ -228,12 +270,16 @@ func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, vali
 		client = http.DefaultClient
 	}
 
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	resp, err := client.Do(req)
 
 	if err != nil {
-		return "", nil, err
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return "", nil, sharedCommon.NewTransportError("verifyUser request failed", err, 0, "")
 	}
 
 	res := &auth.VerifyUserResponse{}
@@ -257,10 +303,31 @@ func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, vali
 	return
 }
 
+//getSessionExpiry asks the API when the session key expires
+func (dsp *DynamicSessionProvider) getSessionExpiry(sessionKey string) (time.Time, error) {
+	client := dsp.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	info, err := auth.GetSessionKeyInfo(sessionKey, dsp.ClientCode, client)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	expireUnixTime, err := strconv.ParseInt(info.ExpireUnixTime, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid session expiry time %q: %w", info.ExpireUnixTime, err)
+	}
+
+	return time.Unix(expireUnixTime, 0).UTC(), nil
+}
+
 func (cb ClientBuilder) Build() *Client {
 	constr := &common.ClientConstructor{}
 	constr.WithClientCode(cb.ClientCode)
 
+	var sessionRefresher *SessionRefresher
 	if cb.SessionProvider != nil {
 		constr.WithSessionProvider(cb.SessionProvider)
 	} else {
@@ -270,9 +337,16 @@ func (cb ClientBuilder) Build() *Client {
 			Pass:                     cb.Password,
 			DefaultSessionLenSeconds: cb.DefaultSessionLenSeconds,
 			Lock:                     sync.Mutex{},
+			HTTPClient:               cb.HttpCli,
+			Metrics:                  cb.Metrics,
+			Store:                    cb.SessionStore,
 		}
 
 		constr.WithSessionProvider(sessProvider)
+
+		if cb.SessionRefresh != nil {
+			sessionRefresher = sessProvider.StartRefresher(*cb.SessionRefresh)
+		}
 	}
 
 	constr.WithPartnerKey(cb.PartnerKey)
@@ -280,8 +354,23 @@ func (cb ClientBuilder) Build() *Client {
 	constr.WithHeaderFunc(cb.HeadersForEveryRequestFunc)
 	constr.WithHttpClient(cb.HttpCli)
 	constr.WithSessionKey(cb.SessionKey)
+	constr.WithInterceptors(cb.Interceptors...)
+	if cb.RetryPolicy != nil {
+		constr.WithRetryPolicy(*cb.RetryPolicy)
+	}
+	for apiMethod, policy := range cb.MethodRetryPolicies {
+		constr.WithMethodRetryPolicy(apiMethod, policy)
+	}
+	constr.WithQuotaTracker(cb.QuotaTracker)
+	constr.WithThrottler(cb.Throttler)
+	constr.WithBulkConcurrency(cb.BulkConcurrency)
+	constr.WithMetrics(cb.Metrics)
+	constr.WithTracer(cb.Tracer)
 
 	baseClient := constr.Build()
 
-	return newErplyClient(baseClient)
+	cli := newErplyClient(baseClient)
+	cli.sessionRefresher = sessionRefresher
+
+	return cli
 }
@@ -0,0 +1,400 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//DefaultPoolIdleTimeout is how long an account of ClientPool can stay unused before its client is evicted
+const DefaultPoolIdleTimeout = 30 * time.Minute
+
+//ErrClientPoolClosed is returned by ClientPool.Get after the pool is closed
+var ErrClientPoolClosed = errors.New("client pool is closed")
+
+//AccountCredentials are the settings of one account of ClientPool, either UserName and Password,
+//SessionKey or SessionProvider should be set
+type AccountCredentials struct {
+	UserName        string
+	Password        string
+	SessionKey      string
+	SessionProvider common.SessionProvider
+	PartnerKey      string
+	//URL overrides the base URL of the account which is derived from its client code by default
+	URL string
+}
+
+//CredentialsFunc gives the credentials of the account, it's called by ClientPool when the client of the account is created
+type CredentialsFunc func(ctx context.Context, clientCode string) (AccountCredentials, error)
+
+type ClientPoolSettings struct {
+	//Credentials is required, it's called once per account and again after the account is evicted
+	Credentials CredentialsFunc
+	//HttpCli is shared by the clients of all accounts, common.GetDefaultHTTPClient() is used if not set
+	HttpCli *http.Client
+	//IdleTimeout evicts accounts which were not used this long, DefaultPoolIdleTimeout if not set, a negative value disables the eviction
+	IdleTimeout time.Duration
+	//NewThrottler creates the rate limiter of an account, the requests are not throttled if it's not set
+	NewThrottler func(clientCode string) sharedCommon.Throttler
+	//Quota enables a separate QuotaTracker for every account
+	Quota *sharedCommon.QuotaSettings
+	//SessionStore persists the session keys of the accounts which log in with the user name and password
+	SessionStore SessionStore
+	//Configure can adjust the builder of every account e.g. to set retry policies, metrics or interceptors
+	Configure func(clientCode string, builder *ClientBuilder)
+}
+
+//AccountHealth describes the state of an account in ClientPool
+type AccountHealth struct {
+	ClientCode string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	//RequestsCount and FailuresCount count the API calls made through the pool client
+	RequestsCount       int
+	FailuresCount       int
+	ConsecutiveFailures int
+	LastSuccessAt       time.Time
+	LastErrorAt         time.Time
+	LastError           error
+	//QuotaRemaining is the amount of requests left in the current hour, it's -1 if the quota is not tracked
+	QuotaRemaining int
+}
+
+//Healthy tells if the last API call of the account didn't fail
+func (ah AccountHealth) Healthy() bool {
+	return ah.ConsecutiveFailures == 0
+}
+
+type poolEntry struct {
+	//ready is closed when the client is created or its creation failed
+	ready  chan struct{}
+	client *Client
+	err    error
+	quota  *sharedCommon.QuotaTracker
+	health AccountHealth
+}
+
+//ClientPool creates the clients of many accounts lazily and keeps them by client code. Every account has its own
+//session, throttler, quota tracker and base URL, while the HTTP connections are shared. Idle accounts are evicted,
+//so their credentials are requested again on the next use
+type ClientPool struct {
+	settings ClientPoolSettings
+	lock     sync.Mutex
+	entries  map[string]*poolEntry
+	closed   bool
+	stop     chan struct{}
+	done     chan struct{}
+	now      func() time.Time
+}
+
+func NewClientPool(settings ClientPoolSettings) *ClientPool {
+	if settings.HttpCli == nil {
+		settings.HttpCli = common.GetDefaultHTTPClient()
+	}
+	if settings.IdleTimeout == 0 {
+		settings.IdleTimeout = DefaultPoolIdleTimeout
+	}
+
+	pool := &ClientPool{
+		settings: settings,
+		entries:  map[string]*poolEntry{},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		now:      time.Now,
+	}
+
+	if settings.IdleTimeout > 0 {
+		go pool.evictIdleInBackground()
+	} else {
+		close(pool.done)
+	}
+
+	return pool
+}
+
+//Get gives the client of the account, it's created on the first call with the credentials of the account.
+//Concurrent calls for the same account wait for one client creation
+func (cp *ClientPool) Get(ctx context.Context, clientCode string) (*Client, error) {
+	if clientCode == "" {
+		return nil, errors.New("clientCode is required")
+	}
+
+	cp.lock.Lock()
+	if cp.closed {
+		cp.lock.Unlock()
+		return nil, ErrClientPoolClosed
+	}
+
+	entry, ok := cp.entries[clientCode]
+	if !ok {
+		entry = &poolEntry{ready: make(chan struct{})}
+		cp.entries[clientCode] = entry
+		cp.lock.Unlock()
+
+		cp.create(ctx, clientCode, entry)
+	} else {
+		cp.lock.Unlock()
+	}
+
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	cp.lock.Lock()
+	entry.health.LastUsedAt = cp.now()
+	cp.lock.Unlock()
+
+	return entry.client, nil
+}
+
+func (cp *ClientPool) create(ctx context.Context, clientCode string, entry *poolEntry) {
+	defer close(entry.ready)
+
+	creds, err := cp.settings.Credentials(ctx, clientCode)
+	if err != nil {
+		log.Log.Log(log.Warn, "failed to get the credentials of the account %s: %v", clientCode, err)
+		entry.err = err
+
+		//the next call should try again
+		cp.lock.Lock()
+		if cp.entries[clientCode] == entry {
+			delete(cp.entries, clientCode)
+		}
+		cp.lock.Unlock()
+		return
+	}
+
+	builder := ClientBuilder{
+		ClientCode:      clientCode,
+		UserName:        creds.UserName,
+		Password:        creds.Password,
+		SessionKey:      creds.SessionKey,
+		SessionProvider: creds.SessionProvider,
+		PartnerKey:      creds.PartnerKey,
+		URL:             creds.URL,
+		HttpCli:         cp.settings.HttpCli,
+		SessionStore:    cp.settings.SessionStore,
+	}
+	if cp.settings.NewThrottler != nil {
+		builder.Throttler = cp.settings.NewThrottler(clientCode)
+	}
+	if cp.settings.Quota != nil {
+		entry.quota = sharedCommon.NewQuotaTracker(*cp.settings.Quota)
+		builder.QuotaTracker = entry.quota
+	}
+	if cp.settings.Configure != nil {
+		cp.settings.Configure(clientCode, &builder)
+	}
+	builder.Interceptors = append([]sharedCommon.Interceptor{cp.healthInterceptor(entry)}, builder.Interceptors...)
+
+	//a session key without a provider is used as it is
+	if builder.SessionProvider == nil && builder.SessionKey != "" && builder.UserName == "" {
+		builder.SessionProvider = &common.DefaultSessionProvider{SessionKey: builder.SessionKey}
+	}
+
+	now := cp.now()
+	cp.lock.Lock()
+	entry.client = builder.Build()
+	entry.health.ClientCode = clientCode
+	entry.health.CreatedAt = now
+	entry.health.LastUsedAt = now
+	cp.lock.Unlock()
+}
+
+//healthInterceptor records the outcome of every API call of the account
+func (cp *ClientPool) healthInterceptor(entry *poolEntry) sharedCommon.Interceptor {
+	return func(ctx context.Context, call *sharedCommon.RequestCall, next sharedCommon.RequestHandler) (*sharedCommon.RequestResult, error) {
+		res, err := next(ctx, call)
+
+		callErr := err
+		if callErr == nil && res != nil && res.Status != nil && res.Status.ErrorCode != 0 {
+			callErr = sharedCommon.NewFromResponseStatus(res.Status)
+		}
+
+		cp.lock.Lock()
+		defer cp.lock.Unlock()
+
+		now := cp.now()
+		health := &entry.health
+		health.LastUsedAt = now
+		health.RequestsCount++
+		if callErr != nil {
+			health.FailuresCount++
+			health.ConsecutiveFailures++
+			health.LastErrorAt = now
+			health.LastError = callErr
+		} else {
+			health.ConsecutiveFailures = 0
+			health.LastSuccessAt = now
+		}
+
+		return res, err
+	}
+}
+
+//Health gives the state of the account, false is returned if the pool has no client for it
+func (cp *ClientPool) Health(clientCode string) (AccountHealth, bool) {
+	cp.lock.Lock()
+	entry, ok := cp.entries[clientCode]
+	cp.lock.Unlock()
+	if !ok {
+		return AccountHealth{}, false
+	}
+
+	select {
+	case <-entry.ready:
+	default:
+		return AccountHealth{}, false
+	}
+
+	return cp.entryHealth(entry), entry.err == nil
+}
+
+//HealthAll gives the states of all accounts which have a client in the pool ordered by client code
+func (cp *ClientPool) HealthAll() []AccountHealth {
+	cp.lock.Lock()
+	entries := make([]*poolEntry, 0, len(cp.entries))
+	for _, entry := range cp.entries {
+		entries = append(entries, entry)
+	}
+	cp.lock.Unlock()
+
+	res := make([]AccountHealth, 0, len(entries))
+	for _, entry := range entries {
+		select {
+		case <-entry.ready:
+		default:
+			continue
+		}
+		if entry.err == nil {
+			res = append(res, cp.entryHealth(entry))
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ClientCode < res[j].ClientCode
+	})
+
+	return res
+}
+
+func (cp *ClientPool) entryHealth(entry *poolEntry) AccountHealth {
+	cp.lock.Lock()
+	health := entry.health
+	cp.lock.Unlock()
+
+	health.QuotaRemaining = -1
+	if entry.quota != nil {
+		health.QuotaRemaining = entry.quota.Remaining()
+	}
+
+	return health
+}
+
+//Evict removes the client of the account from the pool, the client can still be used by the callers which hold it
+//but its background session renewal is stopped
+func (cp *ClientPool) Evict(clientCode string) {
+	cp.lock.Lock()
+	entry, ok := cp.entries[clientCode]
+	if ok {
+		delete(cp.entries, clientCode)
+	}
+	cp.lock.Unlock()
+
+	if ok {
+		cp.release(entry)
+	}
+}
+
+//EvictIdle removes the accounts which were not used longer than the idle timeout and gives their amount
+func (cp *ClientPool) EvictIdle() int {
+	if cp.settings.IdleTimeout <= 0 {
+		return 0
+	}
+
+	deadline := cp.now().Add(-cp.settings.IdleTimeout)
+	var evicted []*poolEntry
+
+	cp.lock.Lock()
+	for clientCode, entry := range cp.entries {
+		select {
+		case <-entry.ready:
+		default:
+			continue
+		}
+		if entry.health.LastUsedAt.Before(deadline) {
+			log.Log.Log(log.Debug, "will evict the idle account %s", clientCode)
+			delete(cp.entries, clientCode)
+			evicted = append(evicted, entry)
+		}
+	}
+	cp.lock.Unlock()
+
+	for _, entry := range evicted {
+		cp.release(entry)
+	}
+
+	return len(evicted)
+}
+
+//Close evicts all accounts, stops the background eviction and closes the idle connections of the shared HTTP client
+func (cp *ClientPool) Close() {
+	cp.lock.Lock()
+	if cp.closed {
+		cp.lock.Unlock()
+		return
+	}
+	cp.closed = true
+	entries := cp.entries
+	cp.entries = map[string]*poolEntry{}
+	cp.lock.Unlock()
+
+	close(cp.stop)
+	<-cp.done
+
+	for _, entry := range entries {
+		<-entry.ready
+		cp.release(entry)
+	}
+	cp.settings.HttpCli.CloseIdleConnections()
+}
+
+//release stops the session renewal of the client, the shared connections are kept for other accounts
+func (cp *ClientPool) release(entry *poolEntry) {
+	if entry.client != nil && entry.client.sessionRefresher != nil {
+		entry.client.sessionRefresher.Stop()
+	}
+}
+
+func (cp *ClientPool) evictIdleInBackground() {
+	defer close(cp.done)
+
+	interval := cp.settings.IdleTimeout / 2
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			cp.EvictIdle()
+		case <-cp.stop:
+			return
+		}
+	}
+}
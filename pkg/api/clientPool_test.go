@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPool(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("request") {
+		case "verifyUser":
+			fmt.Fprintf(w, `{"status":{"responseStatus":"ok"},"records":[{"sessionKey":"key-%s","sessionLength":3600}]}`, r.FormValue("clientCode"))
+		case "getServerTime":
+			assert.Equal(t, "key-"+r.FormValue("clientCode"), r.FormValue("sessionKey"))
+			fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"records":[]}`)
+		default:
+			fmt.Fprint(w, `{"status":{"responseStatus":"error","errorCode":1005},"records":[]}`)
+		}
+	}))
+	defer srv.Close()
+
+	var lock sync.Mutex
+	credentialCalls := map[string]int{}
+	throttlers := map[string]sharedCommon.Throttler{}
+	pool := NewClientPool(ClientPoolSettings{
+		Credentials: func(ctx context.Context, clientCode string) (AccountCredentials, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			credentialCalls[clientCode]++
+			if clientCode == "unknown" {
+				return AccountCredentials{}, errors.New("no credentials")
+			}
+			return AccountCredentials{UserName: "john", Password: "pass", URL: srv.URL}, nil
+		},
+		HttpCli: &http.Client{Transport: redirectingTransport{target: srv.URL}},
+		NewThrottler: func(clientCode string) sharedCommon.Throttler {
+			throttler := sharedCommon.NewTokenBucketThrottler(100, 10)
+			throttlers[clientCode] = throttler
+			return throttler
+		},
+		Quota: &sharedCommon.QuotaSettings{HourlyLimit: 100},
+	})
+	defer pool.Close()
+
+	//concurrent calls for one account create one client
+	wg := sync.WaitGroup{}
+	clients := make([]*Client, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cli, err := pool.Get(context.Background(), "123")
+			assert.NoError(t, err)
+			clients[i] = cli
+		}(i)
+	}
+	wg.Wait()
+	for _, cli := range clients {
+		assert.True(t, cli == clients[0])
+	}
+
+	otherCli, err := pool.Get(context.Background(), "456")
+	assert.NoError(t, err)
+	assert.False(t, otherCli == clients[0])
+	assert.Equal(t, map[string]int{"123": 1, "456": 1}, credentialCalls)
+	assert.Len(t, throttlers, 2)
+
+	assert.NoError(t, clients[0].Call(context.Background(), "getServerTime", nil, nil))
+	assert.NoError(t, otherCli.Call(context.Background(), "getServerTime", nil, nil))
+	assert.Error(t, otherCli.Call(context.Background(), "getUnknown", nil, nil))
+
+	health, ok := pool.Health("123")
+	assert.True(t, ok)
+	assert.True(t, health.Healthy())
+	assert.Equal(t, 1, health.RequestsCount)
+	assert.Equal(t, 99, health.QuotaRemaining)
+
+	health, ok = pool.Health("456")
+	assert.True(t, ok)
+	assert.False(t, health.Healthy())
+	assert.Equal(t, 2, health.RequestsCount)
+	assert.Equal(t, 1, health.FailuresCount)
+	assert.Equal(t, 98, health.QuotaRemaining)
+	var erplyErr *sharedCommon.ErplyError
+	assert.True(t, errors.As(health.LastError, &erplyErr))
+	assert.Equal(t, sharedCommon.UnknownApi, erplyErr.Code)
+
+	allHealth := pool.HealthAll()
+	assert.Len(t, allHealth, 2)
+	assert.Equal(t, "123", allHealth[0].ClientCode)
+	assert.Equal(t, "456", allHealth[1].ClientCode)
+
+	//failed credentials are requested again on the next call
+	_, err = pool.Get(context.Background(), "unknown")
+	assert.EqualError(t, err, "no credentials")
+	_, err = pool.Get(context.Background(), "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, 2, credentialCalls["unknown"])
+	_, ok = pool.Health("unknown")
+	assert.False(t, ok)
+
+	pool.Close()
+	_, err = pool.Get(context.Background(), "123")
+	assert.True(t, errors.Is(err, ErrClientPoolClosed))
+	assert.Empty(t, pool.HealthAll())
+}
+
+func TestClientPoolEvictsIdleAccounts(t *testing.T) {
+	credentialCalls := 0
+	pool := NewClientPool(ClientPoolSettings{
+		Credentials: func(ctx context.Context, clientCode string) (AccountCredentials, error) {
+			credentialCalls++
+			return AccountCredentials{SessionKey: "somesess"}, nil
+		},
+		IdleTimeout: time.Hour,
+	})
+	defer pool.Close()
+
+	now := time.Now()
+	pool.now = func() time.Time {
+		return now
+	}
+
+	_, err := pool.Get(context.Background(), "123")
+	assert.NoError(t, err)
+	_, err = pool.Get(context.Background(), "456")
+	assert.NoError(t, err)
+
+	now = now.Add(40 * time.Minute)
+	_, err = pool.Get(context.Background(), "456")
+	assert.NoError(t, err)
+
+	now = now.Add(40 * time.Minute)
+	assert.Equal(t, 1, pool.EvictIdle())
+	_, ok := pool.Health("123")
+	assert.False(t, ok)
+	_, ok = pool.Health("456")
+	assert.True(t, ok)
+
+	_, err = pool.Get(context.Background(), "123")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, credentialCalls)
+
+	pool.Evict("456")
+	assert.Len(t, pool.HealthAll(), 1)
+}
@@ -3,4 +3,12 @@ package common
 const (
 	MaxBulkRequestsCount       = 100
 	MaxCountPerBulkRequestItem = 100
+	//DefaultBulkConcurrency is the default amount of parallel bulk calls for bulk requests with more than MaxBulkRequestsCount inputs
+	DefaultBulkConcurrency = 2
 )
+
+//BulkInput is a single sub-request of a bulk API call
+type BulkInput struct {
+	MethodName string
+	Filters    map[string]interface{}
+}
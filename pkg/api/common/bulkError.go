@@ -0,0 +1,159 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+//BulkItemError describes a failed sub-request of a bulk API call
+type BulkItemError struct {
+	//Index is the position of the sub-request in the bulk request
+	Index       int
+	RequestID   string
+	RequestName string
+	Code        ApiError
+	ErrorField  string
+	//SendError is the error of the client if the sub-request was never answered by the API, e.g. a transport failure
+	SendError string
+	Status    StatusBulk
+}
+
+//Err converts the failure to an ErplyError with the item's own error code, a sub-request which was never answered
+//belongs to ErrTransport unless the client knows the API error code
+func (i BulkItemError) Err() *ErplyError {
+	status := i.Status.Status
+	if status.Request == "" {
+		status.Request = i.RequestName
+	}
+
+	erplyErr := NewFromResponseStatus(&status)
+	if i.SendError != "" {
+		erplyErr.Message += ", not answered: " + i.SendError
+		if erplyErr.Code == 0 {
+			erplyErr.category = ErrTransport
+		}
+	}
+
+	return erplyErr
+}
+
+func (i BulkItemError) String() string {
+	s := fmt.Sprintf("#%d", i.Index)
+	if i.RequestID != "" {
+		s += fmt.Sprintf(" (requestID: %s)", i.RequestID)
+	}
+	if i.RequestName != "" {
+		s += " " + i.RequestName
+	}
+	s += fmt.Sprintf(": %s, code: %d", i.Code.String(), i.Code)
+	if i.ErrorField != "" {
+		s += ", error field: " + i.ErrorField
+	}
+	if i.SendError != "" {
+		s += ", not answered: " + i.SendError
+	}
+
+	return s
+}
+
+//BulkError lists all failed sub-requests of a bulk API call, the response with the successful items is returned together with it
+type BulkError struct {
+	Items []BulkItemError
+}
+
+//Add registers the status of the sub-request at the given index, successful statuses are ignored
+func (e *BulkError) Add(index int, status StatusBulk) {
+	if strings.EqualFold(status.ResponseStatus, "ok") {
+		return
+	}
+
+	requestName := status.RequestName
+	if requestName == "" {
+		requestName = status.Request
+	}
+
+	e.Items = append(e.Items, BulkItemError{
+		Index:       index,
+		RequestID:   status.RequestID,
+		RequestName: requestName,
+		Code:        status.ErrorCode,
+		ErrorField:  status.ErrorField,
+		SendError:   status.SendError,
+		Status:      status,
+	})
+}
+
+//HasFailures tells if any of the sub-requests failed
+func (e *BulkError) HasFailures() bool {
+	return e != nil && len(e.Items) > 0
+}
+
+//Failed gives the indexes of the failed sub-requests
+func (e *BulkError) Failed() []int {
+	if e == nil {
+		return nil
+	}
+	indexes := make([]int, 0, len(e.Items))
+	for _, item := range e.Items {
+		indexes = append(indexes, item.Index)
+	}
+
+	return indexes
+}
+
+//ItemError gives the failure of the sub-request at the given index or nil if it succeeded
+func (e *BulkError) ItemError(index int) *BulkItemError {
+	if e == nil {
+		return nil
+	}
+	for i := range e.Items {
+		if e.Items[i].Index == index {
+			return &e.Items[i]
+		}
+	}
+
+	return nil
+}
+
+func (e *BulkError) Error() string {
+	failures := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		failures = append(failures, item.String())
+	}
+
+	return fmt.Sprintf("ERPLY API: %d bulk sub-request(s) failed: %s", len(e.Items), strings.Join(failures, "; "))
+}
+
+//Unwrap gives the error of the first failed sub-request, so errors.Is and errors.As work with the error categories and ErplyError
+func (e *BulkError) Unwrap() error {
+	if len(e.Items) == 0 {
+		return nil
+	}
+
+	return e.Items[0].Err()
+}
+
+//Is matches the error against the error category of any failed sub-request
+func (e *BulkError) Is(target error) bool {
+	for _, item := range e.Items {
+		if category := item.Err().Category(); category != nil && category == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+//NewBulkErrorFromStatuses gives a BulkError with the failed ones of count sub-request statuses or nil if all of them succeeded,
+//statusAt gives the status of the sub-request at the index
+func NewBulkErrorFromStatuses(count int, statusAt func(index int) StatusBulk) error {
+	bulkErr := &BulkError{}
+	for i := 0; i < count; i++ {
+		bulkErr.Add(i, statusAt(i))
+	}
+	if bulkErr.HasFailures() {
+		return bulkErr
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func bulkStatus(requestID, responseStatus string, code ApiError) StatusBulk {
+	status := StatusBulk{RequestName: "saveCustomer", RequestID: requestID}
+	status.ResponseStatus = responseStatus
+	status.ErrorCode = code
+
+	return status
+}
+
+func TestBulkError(t *testing.T) {
+	bulkErr := &BulkError{}
+	bulkErr.Add(0, bulkStatus("1", "ok", 0))
+	assert.False(t, bulkErr.HasFailures())
+
+	bulkErr.Add(1, bulkStatus("2", "error", InvalidValue))
+	bulkErr.Add(2, bulkStatus("3", "Error", MissingAuth))
+	bulkErr.Add(3, bulkStatus("4", "OK", 0))
+
+	assert.True(t, bulkErr.HasFailures())
+	assert.Equal(t, []int{1, 2}, bulkErr.Failed())
+	assert.Nil(t, bulkErr.ItemError(0))
+	assert.Equal(t, MissingAuth, bulkErr.ItemError(2).Code)
+
+	assert.Contains(t, bulkErr.Error(), "2 bulk sub-request(s) failed")
+	assert.Contains(t, bulkErr.Error(), "#1 (requestID: 2) saveCustomer")
+	assert.Contains(t, bulkErr.Error(), InvalidValue.String())
+
+	var err error = bulkErr
+	assert.True(t, errors.Is(err, ErrValidation))
+	assert.True(t, errors.Is(err, ErrAuth))
+	assert.False(t, errors.Is(err, ErrQuota))
+
+	var erplyErr *ErplyError
+	assert.True(t, errors.As(err, &erplyErr))
+	assert.Equal(t, InvalidValue, erplyErr.Code)
+	assert.Equal(t, "saveCustomer", erplyErr.Request)
+
+	var nilBulkErr *BulkError
+	assert.False(t, nilBulkErr.HasFailures())
+	assert.Nil(t, nilBulkErr.ItemError(0))
+}
+
+func TestBulkErrorOfNotAnsweredItems(t *testing.T) {
+	notSent := bulkStatus("1", "error", 0)
+	notSent.SendError = "Bulk request failed: connection refused"
+	quota := bulkStatus("2", "error", HourlyRequestQuota)
+	quota.SendError = "quota exceeded"
+
+	bulkErr := &BulkError{}
+	bulkErr.Add(0, notSent)
+	bulkErr.Add(1, quota)
+
+	assert.Equal(t, "Bulk request failed: connection refused", bulkErr.ItemError(0).SendError)
+	assert.Contains(t, bulkErr.Error(), "not answered: Bulk request failed: connection refused")
+	assert.True(t, errors.Is(bulkErr.ItemError(0).Err(), ErrTransport))
+	assert.True(t, errors.Is(bulkErr.ItemError(1).Err(), ErrQuota))
+	assert.True(t, errors.Is(bulkErr, ErrTransport))
+
+	//the sub-requests which the API rejected are not transport errors
+	rejected := &BulkError{}
+	rejected.Add(0, bulkStatus("3", "error", 0))
+	assert.False(t, errors.Is(rejected, ErrTransport))
+}
+
+func TestNewBulkErrorFromStatuses(t *testing.T) {
+	statuses := []StatusBulk{bulkStatus("1", "ok", 0), bulkStatus("2", "error", InvalidValue)}
+	statusAt := func(i int) StatusBulk {
+		return statuses[i]
+	}
+
+	err := NewBulkErrorFromStatuses(len(statuses), statusAt)
+	bulkErr, ok := err.(*BulkError)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1}, bulkErr.Failed())
+
+	assert.NoError(t, NewBulkErrorFromStatuses(1, statusAt))
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"errors"
 	"github.com/erply/api-go-wrapper/pkg/api/log"
 	"time"
 )
@@ -36,9 +37,9 @@ func (c Connector) Run() error {
 			return nil
 		}
 
-		switch e := err.(type) {
-		case *ErplyError:
-			if e.Code == APISessionExpired {
+		var erplyErr *ErplyError
+		if errors.As(err, &erplyErr) {
+			if erplyErr.Code == APISessionExpired {
 				log.Log.Log(log.Error, "failed to connect because auth session is expired: %v", err)
 				log.Log.Log(log.Debug, "will invalidate session")
 				err := c.SessionCleaner()
@@ -46,7 +47,7 @@ func (c Connector) Run() error {
 					return err
 				}
 			}
-		default:
+		} else {
 			log.Log.Log(log.Error, "failed to connect: %v", err)
 		}
 
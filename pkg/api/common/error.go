@@ -339,17 +339,88 @@ func (s ApiError) String() string {
 	return fmt.Sprintf("[%d] %s", int(s), strVal)
 }
 
+//Error categories which can be checked with errors.Is, e.g. errors.Is(err, common.ErrQuota)
+var (
+	ErrAuth        = errors.New("authentication failed")
+	ErrPermission  = errors.New("permission denied")
+	ErrQuota       = errors.New("request quota exceeded")
+	ErrValidation  = errors.New("invalid request")
+	ErrNotFound    = errors.New("not found")
+	ErrMaintenance = errors.New("service is temporarily unavailable")
+	ErrTransport   = errors.New("transport failure")
+)
+
+//Category gives the error category of the code or nil if the code doesn't belong to any
+func (s ApiError) Category() error {
+	switch s {
+	case MissingAuth, AuthMissing, LoginFailed, UserBlocked, MissingSavedPassword, APISessionExpired, InvalidSession,
+		SessionTooOld, DemoAccountExpired, PinLoginNotSupported, AccountNotConfirmed, CustomerCodeMissingInJWT,
+		MissingUsernameInJWT, PendingStatusForUser, NotPossibleToExtendSessionForJWT, WrongJWTAccount,
+		JWTDecodingFailure, JWTExpired:
+		return ErrAuth
+	case ApiNotAvailable, NotUsableField, NoUserGroupDetected, NoViewingRights, NoAddingRights, NoEditingRights,
+		NoDeletingRights, NoLocationAccess, NoAPIAccess, NoGroupManagementRights, WrongAccountFranchise,
+		NoAccessToCustomerData, NoRightsForBackOffice:
+		return ErrPermission
+	case HourlyRequestQuota:
+		return ErrQuota
+	case UnknownApi, UnknownOutputFormat, RequiredParamMissing, InvalidClassifierID, ParamIsNotUnique,
+		InconsistentParam, InvalidFormat, MalformedRequest, InvalidValue, MultipleMatchesFound,
+		TooManyBulkSubRequests, WrongRowsSequence, IdenticalRecordExists, NotAllowedToChangeValue, IncorrectList,
+		ArrayValueRequired, NoFileAttached, WrongFileEncoding, TooBigFile, PasswordLengthFailure,
+		WrongLettersInPassword, PasswordComplexityError, ValueLengthError, TooLongListOfElements,
+		UsernameAlreadyExists, WrongLanguageCode, NotNewPassword:
+		return ErrValidation
+	case AccountNotFound, NoRecordsFound, MissingCouponError, MissingUserName:
+		return ErrNotFound
+	case ServerMaintenance, AccountDbConnError, DbError, SameInstanceIsRunning, CreateAccountError:
+		return ErrMaintenance
+	default:
+		return nil
+	}
+}
+
+//ErplyError the embedded error is the cause of the failure, it's nil for errors reported by the API
 type ErplyError struct {
 	error
-	Status  string
-	Message string
-	Code    ApiError
+	Status     string
+	Message    string
+	Code       ApiError
+	ErrorField string
+	Request    string
+	//HTTPStatus and Body are set only by the transport layer, i.e. for the errors of NewTransportError and the error
+	//statuses detected while sending a request. The errors of NewFromResponseStatus which the managers return after
+	//decoding a response leave them empty
+	HTTPStatus int
+	Body       string
+	category   error
 }
 
 func (e *ErplyError) Error() string {
 	return fmt.Sprintf("ERPLY API: %s, status: %s, code: %d", e.Message, e.Status, e.Code)
 }
 
+//Unwrap gives the cause of the error
+func (e *ErplyError) Unwrap() error {
+	return e.error
+}
+
+//Category gives one of the error categories like ErrAuth or nil if the error doesn't belong to any
+func (e *ErplyError) Category() error {
+	if e.category != nil {
+		return e.category
+	}
+
+	return e.Code.Category()
+}
+
+//Is matches the error against the error categories
+func (e *ErplyError) Is(target error) bool {
+	category := e.Category()
+
+	return category != nil && category == target
+}
+
 func NewErplyError(status, msg string, code ApiError) *ErplyError {
 	return &ErplyError{Status: status, Message: msg, Code: code}
 }
@@ -358,6 +429,7 @@ func NewErplyErrorf(status, msg string, code ApiError, args ...interface{}) *Erp
 	return &ErplyError{Status: status, Message: fmt.Sprintf(msg, args...), Code: code}
 }
 
+//NewFromResponseStatus creates an error from the status of a decoded response, HTTPStatus and Body are not set
 func NewFromResponseStatus(status *Status) *ErplyError {
 	var s string
 	if status.ErrorField != "" {
@@ -366,12 +438,39 @@ func NewFromResponseStatus(status *Status) *ErplyError {
 		s = status.ErrorCode.String()
 	}
 	m := status.Request + ": " + status.ResponseStatus
-	return &ErplyError{Status: s, Message: m, Code: status.ErrorCode}
+	return &ErplyError{
+		Status:     s,
+		Message:    m,
+		Code:       status.ErrorCode,
+		ErrorField: status.ErrorField,
+		Request:    status.Request,
+	}
 }
 
+//NewFromError wraps err with the message, if the code is 0 and err is or wraps an ErplyError, its code, error field and
+//category are kept, so errors.As gives the code reported by the API
 func NewFromError(msg string, err error, code ApiError) *ErplyError {
 	if err != nil {
-		return NewErplyError("Error", errors.Wrap(err, msg).Error(), code)
+		erplyErr := NewErplyError("Error", errors.Wrap(err, msg).Error(), code)
+		erplyErr.error = err
+
+		var cause *ErplyError
+		if code == 0 && errors.As(err, &cause) {
+			erplyErr.Code = cause.Code
+			erplyErr.ErrorField = cause.ErrorField
+			erplyErr.category = cause.category
+		}
+		return erplyErr
 	}
 	return NewErplyError("Error", msg, code)
 }
+
+//NewTransportError creates an error for requests which didn't get a valid answer from the API
+func NewTransportError(msg string, err error, httpStatus int, body string) *ErplyError {
+	erplyErr := NewFromError(msg, err, 0)
+	erplyErr.HTTPStatus = httpStatus
+	erplyErr.Body = body
+	erplyErr.category = ErrTransport
+
+	return erplyErr
+}
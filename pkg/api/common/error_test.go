@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErplyErrorCategories(t *testing.T) {
+	testCases := []struct {
+		code     ApiError
+		category error
+	}{
+		{code: APISessionExpired, category: ErrAuth},
+		{code: JWTExpired, category: ErrAuth},
+		{code: NoViewingRights, category: ErrPermission},
+		{code: HourlyRequestQuota, category: ErrQuota},
+		{code: RequiredParamMissing, category: ErrValidation},
+		{code: NoRecordsFound, category: ErrNotFound},
+		{code: ServerMaintenance, category: ErrMaintenance},
+		{code: DbError, category: ErrMaintenance},
+	}
+
+	for _, testCase := range testCases {
+		err := NewFromResponseStatus(&Status{ErrorCode: testCase.code})
+		assert.True(t, errors.Is(err, testCase.category), testCase.code.String())
+		assert.Equal(t, testCase.category, err.Category())
+	}
+
+	err := NewFromResponseStatus(&Status{ErrorCode: EmailSendingFailure})
+	assert.Nil(t, err.Category())
+	assert.False(t, errors.Is(err, ErrValidation))
+}
+
+func TestErplyErrorFromResponseStatus(t *testing.T) {
+	err := NewFromResponseStatus(&Status{
+		Request:        "saveProduct",
+		ResponseStatus: "error",
+		ErrorCode:      InvalidValue,
+		ErrorField:     "code",
+	})
+
+	assert.Equal(t, "saveProduct", err.Request)
+	assert.Equal(t, "code", err.ErrorField)
+	assert.Equal(t, InvalidValue, err.Code)
+	assert.Equal(t, fmt.Sprintf("ERPLY API: saveProduct: error, status: %s, error field: code, code: %d", InvalidValue.String(), InvalidValue), err.Error())
+	assert.Nil(t, err.Unwrap())
+}
+
+func TestErplyErrorUnwrapping(t *testing.T) {
+	transportErr := NewTransportError("getProducts request failed", context.DeadlineExceeded, 0, "")
+	assert.True(t, errors.Is(transportErr, ErrTransport))
+	assert.True(t, errors.Is(transportErr, context.DeadlineExceeded))
+	assert.Contains(t, transportErr.Error(), "getProducts request failed: context deadline exceeded")
+
+	wrappedErr := NewFromError("GetProducts request failed", transportErr, 0)
+	assert.True(t, errors.Is(wrappedErr, ErrTransport))
+	assert.True(t, errors.Is(wrappedErr, context.DeadlineExceeded))
+	assert.False(t, errors.Is(wrappedErr, ErrAuth))
+
+	var erplyErr *ErplyError
+	assert.True(t, errors.As(fmt.Errorf("sync failed: %w", wrappedErr), &erplyErr))
+	assert.Equal(t, wrappedErr, erplyErr)
+
+	//the code of a wrapped API error is kept
+	apiErr := NewFromResponseStatus(&Status{Request: "getProducts", ResponseStatus: "error", ErrorCode: HourlyRequestQuota, ErrorField: "request"})
+	assert.True(t, errors.As(NewFromError("GetProducts request failed", fmt.Errorf("attempt 2: %w", apiErr), 0), &erplyErr))
+	assert.Equal(t, HourlyRequestQuota, erplyErr.Code)
+	assert.Equal(t, "request", erplyErr.ErrorField)
+	assert.True(t, errors.Is(erplyErr, ErrQuota))
+	assert.Equal(t, DbError, NewFromError("failed to save", apiErr, DbError).Code)
+
+	serverErr := NewTransportError("unexpected response status code 502", nil, 502, "<html>Bad Gateway</html>")
+	assert.True(t, errors.Is(serverErr, ErrTransport))
+	assert.Equal(t, 502, serverErr.HTTPStatus)
+	assert.Equal(t, "<html>Bad Gateway</html>", serverErr.Body)
+}
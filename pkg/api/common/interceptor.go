@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+	"net/http"
+)
+
+//RequestCall describes an outgoing Erply API call as it is seen by interceptors
+type RequestCall struct {
+	//ApiMethod is the name of the Erply API method, it's empty for bulk calls
+	ApiMethod string
+	//Params are the request filters given by the caller, for bulk calls these are the base filters
+	Params map[string]string
+	//BulkRequests are the sub-requests of a bulk call
+	BulkRequests []BulkInput
+}
+
+//IsBulk indicates that the call is sent to the bulk API
+func (rc *RequestCall) IsBulk() bool {
+	return rc.ApiMethod == ""
+}
+
+//MethodName gives the API method name, for bulk calls it's the name shared by all sub-requests or an empty string if they differ
+func (rc *RequestCall) MethodName() string {
+	if !rc.IsBulk() {
+		return rc.ApiMethod
+	}
+
+	methodName := ""
+	for i, bulkRequest := range rc.BulkRequests {
+		if i > 0 && bulkRequest.MethodName != methodName {
+			return ""
+		}
+		methodName = bulkRequest.MethodName
+	}
+
+	return methodName
+}
+
+//RequestResult is the outcome of an API call which is passed back through the interceptors chain
+type RequestResult struct {
+	//Response is the HTTP response, its body can be read by the caller once more
+	Response *http.Response
+	//Body is the raw response body
+	Body []byte
+	//Status is the decoded response status, it's nil if the body contains no status
+	Status *Status
+	//BulkStatuses are the decoded statuses of bulk sub-requests in the order of the sub-requests
+	BulkStatuses []StatusBulk
+	//SessionKey is the session key which was used for the request
+	SessionKey string
+}
+
+//RequestHandler executes an API call
+type RequestHandler func(ctx context.Context, call *RequestCall) (*RequestResult, error)
+
+//Interceptor wraps an API call, it should call next to continue the chain and can inspect or change the call and its result
+type Interceptor func(ctx context.Context, call *RequestCall, next RequestHandler) (*RequestResult, error)
+
+//ChainInterceptors wraps the handler with the interceptors, the first interceptor is the outermost one
+func ChainInterceptors(handler RequestHandler, interceptors ...Interceptor) RequestHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		handler = wrapHandler(handler, interceptors[i])
+	}
+
+	return handler
+}
+
+func wrapHandler(next RequestHandler, interceptor Interceptor) RequestHandler {
+	return func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+		return interceptor(ctx, call, next)
+	}
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"fmt"
+)
+
+//ItemsIterator reads the items of a lister one by one, the typed iterators of the domain packages like
+//products.IterateProducts are built on it. It's not safe for concurrent use
+type ItemsIterator struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	stream     ItemsStream
+	payload    interface{}
+	totalCount int
+	err        error
+	done       bool
+}
+
+//NewItemsIterator starts the listing with a copy of the filters, it's stopped when the context is cancelled,
+//Close is called or the first error is met
+func NewItemsIterator(ctx context.Context, lister *Lister, filters map[string]interface{}) *ItemsIterator {
+	ctx, cancel := context.WithCancel(ctx)
+
+	filtersCopy := make(map[string]interface{}, len(filters))
+	for key, value := range filters {
+		filtersCopy[key] = value
+	}
+
+	return &ItemsIterator{
+		ctx:    ctx,
+		cancel: cancel,
+		stream: lister.Get(ctx, filtersCopy),
+	}
+}
+
+//Next moves to the next item, it returns false when all items are read or the iteration failed, see Err
+func (it *ItemsIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	var item Item
+	var ok bool
+	select {
+	case item, ok = <-it.stream:
+	case <-it.ctx.Done():
+		it.finish(it.ctx.Err())
+		return false
+	}
+
+	if !ok {
+		//the lister stops without an error item when the context is cancelled, so some items might be missing
+		it.finish(it.ctx.Err())
+		return false
+	}
+
+	it.totalCount = item.TotalCount
+	if item.Err != nil {
+		it.finish(item.Err)
+		return false
+	}
+
+	it.payload = item.Payload
+	return true
+}
+
+//Payload gives the current item
+func (it *ItemsIterator) Payload() interface{} {
+	return it.payload
+}
+
+//TotalCount gives the amount of items matching the filters
+func (it *ItemsIterator) TotalCount() int {
+	return it.totalCount
+}
+
+//Err gives the error which stopped the iteration, it's nil if all items were read or Close was called
+func (it *ItemsIterator) Err() error {
+	return it.err
+}
+
+//Close stops the listing, it should be called if the iteration is abandoned before Next returns false
+func (it *ItemsIterator) Close() {
+	it.finish(nil)
+}
+
+//FailUnexpectedPayload stops the iteration with an error about the type of the current item
+func (it *ItemsIterator) FailUnexpectedPayload(expectedType string) {
+	it.finish(fmt.Errorf("unexpected listing item type %T, expected %s", it.payload, expectedType))
+}
+
+func (it *ItemsIterator) finish(err error) {
+	if it.done {
+		return
+	}
+
+	it.done = true
+	it.err = err
+	it.payload = nil
+	it.cancel()
+
+	//the fetchers might be sending items, they exit once the stream is drained
+	go func(stream ItemsStream) {
+		for range stream {
+		}
+	}(it.stream)
+}
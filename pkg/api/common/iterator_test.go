@@ -0,0 +1,68 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemsIterator(t *testing.T) {
+	dp := &DataProviderMock{
+		CountOutputCount: 3,
+		ProductsToRead:   []payloadMock{{ID: 1}, {ID: 2}, {ID: 3}},
+	}
+	lister := NewLister(ListingSettings{}, dp, NullSleeper)
+
+	filters := map[string]interface{}{"filterKey": "filterVal"}
+	it := NewItemsIterator(context.Background(), lister, filters)
+
+	ids := []int{}
+	for it.Next() {
+		ids = append(ids, it.Payload().(payloadMock).ID)
+		assert.Equal(t, 3, it.TotalCount())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.False(t, it.Next())
+	assert.Equal(t, map[string]interface{}{"filterKey": "filterVal"}, filters, "the filters of the caller should not be changed")
+
+	//a nil filter is allowed
+	it = NewItemsIterator(context.Background(), lister, nil)
+	assert.True(t, it.Next())
+	it.Close()
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+func TestItemsIteratorErrors(t *testing.T) {
+	dp := &DataProviderMock{
+		CountOutputCount: 1,
+		ReadErrorStr:     "some read items error",
+	}
+	it := NewItemsIterator(context.Background(), NewLister(ListingSettings{}, dp, NullSleeper), nil)
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "some read items error")
+
+	dp = &DataProviderMock{
+		CountOutputCount: 1,
+		ProductsToRead:   []payloadMock{{ID: 1}},
+	}
+	it = NewItemsIterator(context.Background(), NewLister(ListingSettings{}, dp, NullSleeper), nil)
+	assert.True(t, it.Next())
+	it.FailUnexpectedPayload("products.Product")
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "unexpected listing item type common.payloadMock, expected products.Product")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dp = &DataProviderMock{
+		CountOutputCount: 1000,
+		ProductsToRead:   make([]payloadMock, 100),
+	}
+	it = NewItemsIterator(ctx, NewLister(ListingSettings{MaxItemsPerRequest: 100, MaxFetchersCount: 3}, dp, NullSleeper), nil)
+	assert.True(t, it.Next())
+	cancel()
+	for it.Next() {
+	}
+	assert.Equal(t, context.Canceled, it.Err())
+}
@@ -0,0 +1,210 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//KeysetPagination pages a data provider by a monotonically increasing key of the items like the modification time instead of
+//offset pages planned from one count. The items which move between the pages during the listing are neither skipped nor repeated,
+//the deep pageNo values are not needed and the count is not requested
+type KeysetPagination struct {
+	//KeyFilter gets the greatest key of the previous request, the API should give the items whose key is equal or greater,
+	//e.g. changedSince. The listing starts from the value of this filter if it's given to Get
+	KeyFilter string
+	//OrderFilters must sort the items by the key ascending, e.g. {"orderBy": "changed", "orderByDir": "asc"}
+	OrderFilters map[string]interface{}
+	//ItemKey gives the key and the unique ID of an item, ok is false if the item has an unexpected type
+	ItemKey func(item interface{}) (key int64, id int, ok bool)
+}
+
+//SetKeysetPagination concurrent unsafe setter, Get pages the data provider by the key of the items. The requests are sent one after
+//another since every request needs the greatest key of the previous one, so MaxFetchersCount, Ordered and the checkpoint store
+//are not used. The items have no TotalCount and a failed request stops the listing since the next keys are unknown
+func (p *Lister) SetKeysetPagination(keyset *KeysetPagination) {
+	p.keysetPagination = keyset
+}
+
+func (p *Lister) getByKey(ctx context.Context, span Span, filters map[string]interface{}, startedAt time.Time, result *listingResult) ItemsStream {
+	run := newListingRun(0, filters, nil, startedAt)
+	run.result = result
+	parentChan := p.newOutputChan(run)
+
+	go func() {
+		p.fetchByKey(ctx, run, parentChan)
+		p.finishListing(ctx, span, run, parentChan)
+	}()
+
+	return parentChan
+}
+
+//fetchByKey requests the pages from the greatest key of the previous request. Since the key filter includes the items with the
+//same key, the ones which were already given are skipped by their IDs. If all items of a request have the same key, the next
+//pages of this key are requested
+func (p *Lister) fetchByKey(ctx context.Context, run *listingRun, parentChan ItemsStream) {
+	keyset := p.keysetPagination
+
+	key, err := parseKeysetKey(run.filters[keyset.KeyFilter])
+	if err != nil {
+		err = fmt.Errorf("invalid %s filter for the keyset pagination: %v", keyset.KeyFilter, err)
+		run.addFailure(nil, err)
+		p.emitByKey(ctx, parentChan, Item{Err: err})
+		return
+	}
+
+	limit := p.listingSettings.MaxItemsPerRequest
+	if limit > MaxCountPerBulkRequestItem {
+		limit = MaxCountPerBulkRequestItem
+	}
+	bulkItemsCount := CeilDivisionInt(p.listingSettings.MaxItemsPerRequest, limit)
+	if bulkItemsCount > MaxBulkRequestsCount {
+		bulkItemsCount = MaxBulkRequestsCount
+	}
+
+	//seenIDs are the IDs of the given items with the current key
+	seenIDs := map[int]struct{}{}
+	firstPage := 1
+	unorderedWarned := false
+
+	for {
+		cursors := make([]Cursor, 0, bulkItemsCount)
+		bulkFilters := make([]map[string]interface{}, 0, bulkItemsCount)
+		for i := 0; i < bulkItemsCount; i++ {
+			cursor := Cursor{Limit: limit, Offset: firstPage + i}
+			bulkFilter := make(map[string]interface{}, len(run.filters)+len(keyset.OrderFilters)+3)
+			for filterKey, filterValue := range run.filters {
+				bulkFilter[filterKey] = filterValue
+			}
+			for filterKey, filterValue := range keyset.OrderFilters {
+				bulkFilter[filterKey] = filterValue
+			}
+			bulkFilter[keyset.KeyFilter] = key
+			bulkFilter["recordsOnPage"] = cursor.Limit
+			bulkFilter["pageNo"] = cursor.Offset
+
+			cursors = append(cursors, cursor)
+			bulkFilters = append(bulkFilters, bulkFilter)
+		}
+
+		pageCtx, pageSpan := p.tracer.StartSpan(ctx, SpanListerPage)
+		pageSpan.SetAttribute(AttrPageFrom, firstPage)
+		pageSpan.SetAttribute(AttrPageTo, firstPage+bulkItemsCount-1)
+
+		if err := tracedThrottle(pageCtx, p.tracer, p.reqThrottler); err != nil {
+			//the context is cancelled, so nobody is waiting for the error item
+			pageSpan.RecordError(err)
+			pageSpan.End()
+			return
+		}
+
+		maxKey := key
+		lastKey := key
+		maxKeyIDs := seenIDs
+		receivedCount := 0
+		cancelled := false
+		var itemErr error
+		_, err := p.readPage(pageCtx, run, cursors, bulkFilters, func(payload interface{}) {
+			receivedCount++
+			if itemErr != nil || cancelled {
+				return
+			}
+
+			itemKey, id, ok := keyset.ItemKey(payload)
+			if !ok {
+				itemErr = fmt.Errorf("unexpected listing item type %T for the keyset pagination", payload)
+				return
+			}
+			if itemKey < lastKey && !unorderedWarned {
+				log.Log.Log(log.Warn, "the items of the keyset pagination by %s are not ordered by the key, some items can be skipped, check the order filters", keyset.KeyFilter)
+				unorderedWarned = true
+			}
+			lastKey = itemKey
+
+			if itemKey == key {
+				if _, seen := seenIDs[id]; seen {
+					return
+				}
+			}
+			if itemKey > maxKey {
+				maxKey = itemKey
+				maxKeyIDs = map[int]struct{}{}
+			}
+			if itemKey == maxKey {
+				maxKeyIDs[id] = struct{}{}
+			}
+
+			if !p.emitByKey(ctx, parentChan, Item{Payload: payload}) {
+				cancelled = true
+				return
+			}
+			run.addDelivered()
+		})
+		if err == nil {
+			err = itemErr
+		}
+
+		pageSpan.SetAttribute(AttrItemsCount, receivedCount)
+		if err != nil {
+			pageSpan.RecordError(err)
+		}
+		pageSpan.End()
+
+		if cancelled || ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			run.addFailure(cursors, err)
+			p.emitByKey(ctx, parentChan, Item{Err: err})
+			return
+		}
+
+		//a request which is not full has the last items
+		if receivedCount < limit*bulkItemsCount {
+			return
+		}
+
+		if maxKey > key {
+			key = maxKey
+			firstPage = 1
+		} else {
+			firstPage += bulkItemsCount
+		}
+		seenIDs = maxKeyIDs
+	}
+}
+
+func (p *Lister) emitByKey(ctx context.Context, parentChan ItemsStream, item Item) bool {
+	select {
+	case parentChan <- item:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+//parseKeysetKey reads the start key given in the filters, the listing starts from 0 if there is none
+func parseKeysetKey(value interface{}) (int64, error) {
+	switch key := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return int64(key), nil
+	case int64:
+		return key, nil
+	case uint64:
+		return int64(key), nil
+	case float64:
+		return int64(key), nil
+	case json.Number:
+		return key.Int64()
+	case string:
+		return strconv.ParseInt(key, 10, 64)
+	default:
+		return 0, fmt.Errorf("unsupported keyset pagination key %v of type %T", value, value)
+	}
+}
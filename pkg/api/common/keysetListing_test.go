@@ -0,0 +1,145 @@
+package common
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type keyedPayloadMock struct {
+	ID  int
+	Key int64
+}
+
+//liveTableMock gives the records with a key equal or greater than changedSince ordered by the key, afterRead can change the records
+type liveTableMock struct {
+	lock       sync.Mutex
+	keys       map[int]int64
+	readsCount int
+	countCalls int
+	afterRead  func(readNo int, keys map[int]int64)
+}
+
+func (ltm *liveTableMock) Count(ctx context.Context, filters map[string]interface{}) (int, error) {
+	ltm.lock.Lock()
+	defer ltm.lock.Unlock()
+
+	ltm.countCalls++
+	return len(ltm.keys), nil
+}
+
+func (ltm *liveTableMock) Read(ctx context.Context, bulkFilters []map[string]interface{}, callback func(item interface{})) error {
+	ltm.lock.Lock()
+	defer ltm.lock.Unlock()
+
+	ltm.readsCount++
+	for _, bulkFilter := range bulkFilters {
+		changedSince := bulkFilter["changedSince"].(int64)
+		records := make([]keyedPayloadMock, 0, len(ltm.keys))
+		for id, key := range ltm.keys {
+			if key >= changedSince {
+				records = append(records, keyedPayloadMock{ID: id, Key: key})
+			}
+		}
+		sort.Slice(records, func(i, j int) bool {
+			if records[i].Key != records[j].Key {
+				return records[i].Key < records[j].Key
+			}
+			return records[i].ID < records[j].ID
+		})
+
+		limit := bulkFilter["recordsOnPage"].(int)
+		for i := (bulkFilter["pageNo"].(int) - 1) * limit; i < len(records) && i < bulkFilter["pageNo"].(int)*limit; i++ {
+			callback(records[i])
+		}
+	}
+
+	if ltm.afterRead != nil {
+		ltm.afterRead(ltm.readsCount, ltm.keys)
+	}
+
+	return nil
+}
+
+var keyedPayloadKeyset = &KeysetPagination{
+	KeyFilter:    "changedSince",
+	OrderFilters: map[string]interface{}{"orderBy": "changed"},
+	ItemKey: func(item interface{}) (int64, int, bool) {
+		payload, ok := item.(keyedPayloadMock)
+		return payload.Key, payload.ID, ok
+	},
+}
+
+func TestKeysetListingWithChangingRecords(t *testing.T) {
+	dp := &liveTableMock{keys: map[int]int64{}}
+	for id := 1; id <= 12; id++ {
+		dp.keys[id] = 100
+		if id > 5 {
+			dp.keys[id] += int64(id)
+		}
+	}
+	dp.afterRead = func(readNo int, keys map[int]int64) {
+		if readNo == 1 {
+			//the given record moves to the end and the next one moves ahead of the records which are not given yet
+			keys[2] = 200
+			keys[9] = 150
+		}
+	}
+
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 3}, dp, NullSleeper)
+	lister.SetKeysetPagination(keyedPayloadKeyset)
+
+	ids := []int{}
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+		ids = append(ids, item.Payload.(keyedPayloadMock).ID)
+	}
+
+	//the changed record is given again with its new key, the others once
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 10, 11, 12, 9, 2}, ids)
+	assert.Equal(t, 0, dp.countCalls)
+	assert.Equal(t, 7, dp.readsCount)
+
+	summary := lister.Summary()
+	assert.Equal(t, 13, summary.DeliveredCount)
+	assert.True(t, summary.Complete())
+}
+
+func TestKeysetListingStartKeyAndErrors(t *testing.T) {
+	dp := &liveTableMock{keys: map[int]int64{1: 100, 2: 105, 3: 110}}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2}, dp, NullSleeper)
+	lister.SetKeysetPagination(keyedPayloadKeyset)
+
+	ids, err := collectKeyedIDs(lister.Get(context.Background(), map[string]interface{}{"changedSince": strconv.Itoa(105)}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, ids)
+
+	_, err = collectKeyedIDs(lister.Get(context.Background(), map[string]interface{}{"changedSince": "yesterday"}))
+	assert.EqualError(t, err, `invalid changedSince filter for the keyset pagination: strconv.ParseInt: parsing "yesterday": invalid syntax`)
+	assert.False(t, lister.Summary().Complete())
+
+	lister.SetKeysetPagination(&KeysetPagination{
+		KeyFilter: "changedSince",
+		ItemKey: func(item interface{}) (int64, int, bool) {
+			return 0, 0, false
+		},
+	})
+	_, err = collectKeyedIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.EqualError(t, err, "unexpected listing item type common.keyedPayloadMock for the keyset pagination")
+}
+
+func collectKeyedIDs(itemsChan ItemsStream) (ids []int, err error) {
+	for item := range itemsChan {
+		if item.Err != nil {
+			err = item.Err
+			continue
+		}
+		ids = append(ids, item.Payload.(keyedPayloadMock).ID)
+	}
+
+	return ids, err
+}
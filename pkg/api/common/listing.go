@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"sync"
+	"time"
 )
 
 const DefaultMaxFetchersCount = 1
@@ -14,6 +15,15 @@ type ListingSettings struct {
 	StreamBufferLength        int
 	MaxFetchersCount          int
 	MaxItemsPerRequest        int
+	//ErrorPolicy tells what to do when a page cannot be read, the failed pages are skipped and reported by default
+	ErrorPolicy ListingErrorPolicy
+	//PageRetry is used by the ListingErrorRetryPage policy, DefaultRetryPolicy is taken if AttemptsCount is 0
+	PageRetry RetryPolicy
+	//Ordered makes Get give the items in the page order while the pages are still fetched concurrently
+	Ordered bool
+	//ReorderBufferLength is the amount of bulk requests which can be planned or fetched ahead of the one given to the consumer
+	//in the ordered mode, it limits the memory to ReorderBufferLength+1 fetched bulk requests. Defaults to 2*MaxFetchersCount
+	ReorderBufferLength int
 }
 
 type Cursor struct {
@@ -29,6 +39,8 @@ type Item struct {
 	Err        error
 	TotalCount int
 	Payload    interface{}
+	//doneCursors marks the end of a fetched bulk request, such items are not given to the consumer
+	doneCursors []Cursor
 }
 
 func setListingSettingsDefaults(settingsFromInput ListingSettings) ListingSettings {
@@ -44,6 +56,14 @@ func setListingSettingsDefaults(settingsFromInput ListingSettings) ListingSettin
 		settingsFromInput.MaxFetchersCount = DefaultMaxFetchersCount
 	}
 
+	if settingsFromInput.Ordered && settingsFromInput.ReorderBufferLength <= 0 {
+		settingsFromInput.ReorderBufferLength = 2 * settingsFromInput.MaxFetchersCount
+	}
+
+	if settingsFromInput.ErrorPolicy == ListingErrorRetryPage && settingsFromInput.PageRetry.AttemptsCount == 0 {
+		settingsFromInput.PageRetry = DefaultRetryPolicy()
+	}
+
 	return settingsFromInput
 }
 
@@ -56,6 +76,12 @@ type Lister struct {
 	listingSettings     ListingSettings
 	reqThrottler        Throttler
 	listingDataProvider DataProvider
+	tracer              Tracer
+	checkpointStore     CheckpointStore
+	checkpointKey       string
+	summaryLock         sync.Mutex
+	lastSummary         ListingSummary
+	keysetPagination    *KeysetPagination
 }
 
 func NewLister(settings ListingSettings, dataProvider DataProvider, sl Sleeper) *Lister {
@@ -67,6 +93,7 @@ func NewLister(settings ListingSettings, dataProvider DataProvider, sl Sleeper)
 		listingSettings:     settings,
 		reqThrottler:        thrl,
 		listingDataProvider: dataProvider,
+		tracer:              NoopTracer{},
 	}
 }
 
@@ -75,6 +102,21 @@ func (p *Lister) SetRequestThrottler(thrl Throttler) {
 	p.reqThrottler = thrl
 }
 
+//SetTracer concurrent unsafe setter, the listing, each page fetch and throttle wait will be traced as spans,
+//the spans of the API calls become children of the page spans if the client uses the same tracer
+func (p *Lister) SetTracer(tracer Tracer) {
+	p.tracer = tracer
+}
+
+//SetCheckpointStore concurrent unsafe setter, the progress of Get is saved to the store under the key, so a failed or crashed listing
+//with the same filters continues from the pages which were not delivered yet. If the key is empty, it's derived from the data provider
+//type and the filters. A page is recorded once the consumer received all its items, therefore the output channel is not buffered.
+//The checkpoint is deleted after all items were delivered
+func (p *Lister) SetCheckpointStore(store CheckpointStore, key string) {
+	p.checkpointStore = store
+	p.checkpointKey = key
+}
+
 func (p *Lister) GetGrouped(ctx context.Context, filters map[string]interface{}, groupSize int) ItemsStreamGrouped {
 	itemsStream := p.Get(ctx, filters)
 	groupedItemsChan := make(ItemsStreamGrouped, p.listingSettings.MaxFetchersCount)
@@ -111,13 +153,50 @@ func (p *Lister) GetGrouped(ctx context.Context, filters map[string]interface{},
 }
 
 func (p *Lister) Get(ctx context.Context, filters map[string]interface{}) ItemsStream {
-	p.reqThrottler.Throttle()
+	itemsStream, _ := p.GetWithSummary(ctx, filters)
+	return itemsStream
+}
+
+//GetWithSummary works like Get, the returned func gives the summary of this call once the stream is closed.
+//Unlike Summary it's not replaced by the other Get calls of the lister, so it can be used with concurrent calls
+func (p *Lister) GetWithSummary(ctx context.Context, filters map[string]interface{}) (ItemsStream, func() ListingSummary) {
+	startedAt := time.Now().UTC()
+	result := &listingResult{}
+	ctx, span := p.tracer.StartSpan(ctx, SpanListerGet)
+
+	err := tracedThrottle(ctx, p.tracer, p.reqThrottler)
+	if err != nil {
+		span.RecordError(err)
+		span.End()
+		p.setSummary(result, ListingSummary{Errors: []error{err}, Cancelled: true, StartedAt: startedAt, FinishedAt: time.Now().UTC()})
+		outputChan := make(ItemsStream, 1)
+		defer close(outputChan)
+
+		outputChan <- Item{
+			Err: err,
+		}
+		return outputChan, result.get
+	}
+
+	if p.keysetPagination != nil {
+		return p.getByKey(ctx, span, filters, startedAt, result), result.get
+	}
+
+	checkpointKey := p.checkpointKey
+	if p.checkpointStore != nil && checkpointKey == "" {
+		checkpointKey = deriveCheckpointKey(p.listingDataProvider, filters)
+	}
 
 	filters["recordsOnPage"] = 1
 	filters["pageNo"] = 1
 
-	totalCount, err := p.listingDataProvider.Count(ctx, filters)
+	countCtx, countSpan := p.tracer.StartSpan(ctx, SpanListerCount)
+	totalCount, err := p.listingDataProvider.Count(countCtx, filters)
+	countSpan.End()
 	if err != nil {
+		span.RecordError(err)
+		span.End()
+		p.setSummary(result, ListingSummary{Errors: []error{err}, StartedAt: startedAt, FinishedAt: time.Now().UTC()})
 		outputChan := make(ItemsStream, 1)
 		defer close(outputChan)
 
@@ -126,30 +205,60 @@ func (p *Lister) Get(ctx context.Context, filters map[string]interface{}) ItemsS
 			TotalCount: totalCount,
 			Payload:    nil,
 		}
-		return outputChan
+		return outputChan, result.get
+	}
+
+	var tracker *checkpointTracker
+	if p.checkpointStore != nil {
+		tracker, totalCount = p.resumeCheckpoint(checkpointKey, totalCount)
+	}
+
+	span.SetAttribute(AttrTotalCount, totalCount)
+
+	run := newListingRun(totalCount, filters, tracker, startedAt)
+	run.result = result
+	if p.listingSettings.Ordered {
+		return p.getOrdered(ctx, span, run), result.get
 	}
 
-	cursorsChan := p.getCursors(ctx, totalCount)
+	cursorsChan := p.getCursors(ctx, run, nil)
 
 	childChans := make([]ItemsStream, 0, p.listingSettings.MaxFetchersCount)
 	for i := 0; i < p.listingSettings.MaxFetchersCount; i++ {
-		childChan := p.fetchItemsChunk(ctx, cursorsChan, totalCount, filters)
+		childChan := p.fetchItemsChunk(ctx, cursorsChan, run)
 		childChans = append(childChans, childChan)
 	}
 
-	return p.mergeChannels(ctx, childChans...)
+	return p.mergeChannels(ctx, span, run, childChans...), result.get
 }
 
-func (p *Lister) fetchItemsChunk(ctx context.Context, cursorChan chan []Cursor, totalCount int, filters map[string]interface{}) ItemsStream {
+func (p *Lister) fetchItemsChunk(ctx context.Context, batchesChan chan *cursorsBatch, run *listingRun) ItemsStream {
 	prodStream := make(chan Item, p.listingSettings.StreamBufferLength)
+	emit := func(item Item) bool {
+		//nobody reads the items after the context is cancelled, so they are dropped to let the fetcher exit
+		select {
+		case prodStream <- item:
+			return true
+		case <-ctx.Done():
+			return false
+		case <-run.stopped:
+			return false
+		}
+	}
+
 	go func() {
 		defer close(prodStream)
-		for cursors := range cursorChan {
-			p.fetchItemsFromAPI(ctx, cursors, totalCount, prodStream, filters)
+		for batch := range batchesChan {
+			ok := p.fetchItemsFromAPI(ctx, batch.cursors, emit, run)
+			if !ok && p.listingSettings.ErrorPolicy == ListingErrorFailFast {
+				return
+			}
 
 			select {
 			case <-ctx.Done():
 				return
+			case <-run.stopped:
+				return
 			default:
 				continue
 			}
@@ -159,13 +268,27 @@ func (p *Lister) fetchItemsChunk(ctx context.Context, cursorChan chan []Cursor,
 	return prodStream
 }
 
-func (p *Lister) getCursors(ctx context.Context, totalCount int) chan []Cursor {
-	out := make(chan []Cursor, p.listingSettings.MaxFetchersCount)
+//cursorsBatch is one bulk request of the listing
+type cursorsBatch struct {
+	cursors []Cursor
+	//items and done are used in the ordered mode, the items are kept till the previous batches are given to the consumer
+	items []Item
+	done  chan struct{}
+}
+
+//getCursors plans the pages of the listing, the pages which are done according to the checkpoint are skipped.
+//If order is not nil, every batch is also sent there before it's given to the fetchers
+func (p *Lister) getCursors(ctx context.Context, run *listingRun, order chan<- *cursorsBatch) chan *cursorsBatch {
+	out := make(chan *cursorsBatch, p.listingSettings.MaxFetchersCount)
 
-	leftCount := totalCount
+	leftCount := run.totalCount
+	tracker := run.tracker
 
 	go func() {
 		defer close(out)
+		if order != nil {
+			defer close(order)
+		}
 
 		curPage := 1
 		if p.listingSettings.MaxItemsPerRequest > MaxCountPerBulkRequestItem*MaxBulkRequestsCount {
@@ -190,21 +313,39 @@ func (p *Lister) getCursors(ctx context.Context, totalCount int) chan []Cursor {
 
 			cursorsForBulkRequest := make([]Cursor, 0, bulkItemsCount)
 			for i := 0; i < bulkItemsCount; i++ {
-				cursorsForBulkRequest = append(
-					cursorsForBulkRequest,
-					Cursor{
-						Limit:  limit,
-						Offset: curPage,
-					},
-				)
+				cursor := Cursor{
+					Limit:  limit,
+					Offset: curPage,
+				}
 				curPage++
 				leftCount -= limit
+
+				if tracker != nil && tracker.isDone(cursor) {
+					continue
+				}
+				cursorsForBulkRequest = append(cursorsForBulkRequest, cursor)
+			}
+			if len(cursorsForBulkRequest) == 0 {
+				continue
+			}
+
+			batch := &cursorsBatch{cursors: cursorsForBulkRequest, done: make(chan struct{})}
+			if order != nil {
+				select {
+				case order <- batch:
+				case <-ctx.Done():
+					return
+				case <-run.stopped:
+					return
+				}
 			}
 			select {
-			case out <- cursorsForBulkRequest:
+			case out <- batch:
 				continue
 			case <-ctx.Done():
 				return
+			case <-run.stopped:
+				return
 			}
 		}
 	}()
@@ -212,17 +353,19 @@ func (p *Lister) getCursors(ctx context.Context, totalCount int) chan []Cursor {
 	return out
 }
 
+//fetchItemsFromAPI gives the items of the cursors to emit, it returns false if the pages failed.
+//emit returns false if the item was dropped, then the cursors are not marked as done
 func (p *Lister) fetchItemsFromAPI(
 	ctx context.Context,
 	cursors []Cursor,
-	totalCount int,
-	outputChan ItemsStream,
-	filters map[string]interface{},
-) {
+	emit func(item Item) bool,
+	run *listingRun,
+) bool {
+	totalCount := run.totalCount
 	bulkFilters := make([]map[string]interface{}, 0, len(cursors))
 	for _, cursor := range cursors {
 		bulkFilter := make(map[string]interface{})
-		for filterKey, filterValue := range filters {
+		for filterKey, filterValue := range run.filters {
 			bulkFilter[filterKey] = filterValue
 		}
 		bulkFilter["recordsOnPage"] = cursor.Limit
@@ -230,28 +373,58 @@ func (p *Lister) fetchItemsFromAPI(
 		bulkFilters = append(bulkFilters, bulkFilter)
 	}
 
-	p.reqThrottler.Throttle()
+	ctx, span := p.tracer.StartSpan(ctx, SpanListerPage)
+	defer span.End()
+	if len(cursors) > 0 {
+		span.SetAttribute(AttrPageFrom, cursors[0].Offset)
+		span.SetAttribute(AttrPageTo, cursors[len(cursors)-1].Offset)
+	}
 
-	err := p.listingDataProvider.Read(ctx, bulkFilters, func(item interface{}) {
-		outputChan <- Item{
+	err := tracedThrottle(ctx, p.tracer, p.reqThrottler)
+	if err != nil {
+		//the context is cancelled, so nobody is waiting for the error item
+		span.RecordError(err)
+		return false
+	}
+
+	dropped := false
+	itemsCount, err := p.readPage(ctx, run, cursors, bulkFilters, func(item interface{}) {
+		if !emit(Item{
 			Err:        nil,
 			TotalCount: totalCount,
 			Payload:    item,
+		}) {
+			dropped = true
 		}
 	})
 
+	span.SetAttribute(AttrItemsCount, itemsCount)
+
 	if err != nil {
-		outputChan <- Item{
+		span.RecordError(err)
+		if ctx.Err() != nil {
+			//the pages are not failed, the listing was stopped
+			return false
+		}
+		run.addFailure(cursors, err)
+		emit(Item{
 			Err:        err,
 			TotalCount: totalCount,
 			Payload:    nil,
-		}
-		return
+		})
+		return false
+	}
+
+	if run.tracker != nil && !dropped {
+		emit(Item{doneCursors: cursors})
 	}
+
+	return true
 }
 
-func (p *Lister) mergeChannels(ctx context.Context, childChans ...ItemsStream) ItemsStream {
-	parentChan := make(ItemsStream, p.listingSettings.StreamBufferLength)
+//mergeChannels forwards the items of the child channels to one channel and ends the listing span once all of them are closed
+func (p *Lister) mergeChannels(ctx context.Context, span Span, run *listingRun, childChans ...ItemsStream) ItemsStream {
+	parentChan := p.newOutputChan(run)
 
 	var wg sync.WaitGroup
 	wg.Add(len(childChans))
@@ -260,10 +433,7 @@ func (p *Lister) mergeChannels(ctx context.Context, childChans ...ItemsStream) I
 		go func(productsChildChan <-chan Item) {
 			defer wg.Done()
 			for prod := range productsChildChan {
-				select {
-				case parentChan <- prod:
-					continue
-				case <-ctx.Done():
+				if !p.forwardItem(ctx, run, parentChan, prod) {
 					return
 				}
 			}
@@ -272,12 +442,64 @@ func (p *Lister) mergeChannels(ctx context.Context, childChans ...ItemsStream) I
 
 	go func() {
 		wg.Wait()
-		close(parentChan)
+		p.finishListing(ctx, span, run, parentChan)
 	}()
 
 	return parentChan
 }
 
+func (p *Lister) newOutputChan(run *listingRun) ItemsStream {
+	if run.tracker != nil {
+		//a sent item is received by the consumer only if the channel is not buffered
+		return make(ItemsStream)
+	}
+
+	return make(ItemsStream, p.listingSettings.StreamBufferLength)
+}
+
+//forwardItem gives the item of a fetcher to the consumer, it returns false if the context is cancelled
+func (p *Lister) forwardItem(ctx context.Context, run *listingRun, parentChan ItemsStream, prod Item) bool {
+	//the items which are still buffered after the fail-fast stop are dropped
+	if run.isStopped() {
+		return true
+	}
+	//the items of a fetcher are ordered, so all items of the cursors were already delivered unless the listing was stopped
+	if prod.doneCursors != nil {
+		if ctx.Err() == nil && !run.isStopped() {
+			run.tracker.markDone(prod.doneCursors)
+		}
+		return true
+	}
+	if prod.Err != nil && run.tracker != nil {
+		run.tracker.fail()
+	}
+
+	select {
+	case parentChan <- prod:
+	case <-ctx.Done():
+		return false
+	}
+
+	if prod.Err == nil {
+		run.addDelivered()
+	} else if p.listingSettings.ErrorPolicy == ListingErrorFailFast {
+		//the error is received by the consumer before the other fetchers are stopped
+		run.stop()
+	}
+
+	return true
+}
+
+//finishListing is called once all fetchers are stopped, the summary is set before the output channel is closed
+func (p *Lister) finishListing(ctx context.Context, span Span, run *listingRun, parentChan ItemsStream) {
+	if run.tracker != nil {
+		run.tracker.finish(ctx, run.isStopped())
+	}
+	p.setSummary(run.result, run.finish(ctx.Err() != nil || run.isStopped()))
+	span.End()
+	close(parentChan)
+}
+
 func CeilDivisionInt(x, y int) int {
 	return int(math.Ceil(float64(x) / float64(y)))
 }
@@ -0,0 +1,285 @@
+package common
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//CursorRange covers the cursors with the same limit and the offsets from From till To including both
+type CursorRange struct {
+	Limit int `json:"limit"`
+	From  int `json:"from"`
+	To    int `json:"to"`
+}
+
+//ListingCheckpoint is the progress of a listing, it's saved after every fetched bulk request
+type ListingCheckpoint struct {
+	//TotalCount is the amount of items at the start of the first run, the resumed runs plan the pages with it
+	TotalCount int `json:"totalCount"`
+	//Done are the page cursors whose items were all received by the consumer
+	Done      []CursorRange `json:"done"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+//IsDone tells if the items of the cursor were all delivered
+func (lc *ListingCheckpoint) IsDone(cursor Cursor) bool {
+	for _, cursorRange := range lc.Done {
+		if cursorRange.Limit == cursor.Limit && cursor.Offset >= cursorRange.From && cursor.Offset <= cursorRange.To {
+			return true
+		}
+	}
+
+	return false
+}
+
+//MarkDone adds the cursor to Done, the adjacent cursors with the same limit are merged into one range
+func (lc *ListingCheckpoint) MarkDone(cursor Cursor) {
+	if lc.IsDone(cursor) {
+		return
+	}
+
+	ranges := append(lc.Done, CursorRange{Limit: cursor.Limit, From: cursor.Offset, To: cursor.Offset})
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Limit != ranges[j].Limit {
+			return ranges[i].Limit < ranges[j].Limit
+		}
+		return ranges[i].From < ranges[j].From
+	})
+
+	merged := make([]CursorRange, 0, len(ranges))
+	for _, cursorRange := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && merged[last].Limit == cursorRange.Limit && merged[last].To+1 >= cursorRange.From {
+			if cursorRange.To > merged[last].To {
+				merged[last].To = cursorRange.To
+			}
+			continue
+		}
+		merged = append(merged, cursorRange)
+	}
+
+	lc.Done = merged
+}
+
+//CheckpointStore persists the progress of listings by the key given to Lister.SetCheckpointStore
+type CheckpointStore interface {
+	//Load gives the saved checkpoint or nil if there is none
+	Load(key string) (*ListingCheckpoint, error)
+	Save(key string, checkpoint ListingCheckpoint) error
+	Delete(key string) error
+}
+
+//MemoryCheckpointStore keeps the checkpoints in memory, e.g. to resume a listing after a failure in the same process
+type MemoryCheckpointStore struct {
+	lock        sync.Mutex
+	checkpoints map[string]ListingCheckpoint
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: map[string]ListingCheckpoint{}}
+}
+
+func (mcs *MemoryCheckpointStore) Load(key string) (*ListingCheckpoint, error) {
+	mcs.lock.Lock()
+	defer mcs.lock.Unlock()
+
+	checkpoint, ok := mcs.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	checkpoint.Done = append([]CursorRange{}, checkpoint.Done...)
+
+	return &checkpoint, nil
+}
+
+func (mcs *MemoryCheckpointStore) Save(key string, checkpoint ListingCheckpoint) error {
+	mcs.lock.Lock()
+	defer mcs.lock.Unlock()
+
+	checkpoint.Done = append([]CursorRange{}, checkpoint.Done...)
+	mcs.checkpoints[key] = checkpoint
+	return nil
+}
+
+func (mcs *MemoryCheckpointStore) Delete(key string) error {
+	mcs.lock.Lock()
+	defer mcs.lock.Unlock()
+
+	delete(mcs.checkpoints, key)
+	return nil
+}
+
+var checkpointFileNameReplacer = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+//FileCheckpointStore keeps every checkpoint in a JSON file in Dir, so a listing can be resumed after a crash
+type FileCheckpointStore struct {
+	Dir string
+}
+
+func NewFileCheckpointStore(dir string) *FileCheckpointStore {
+	return &FileCheckpointStore{Dir: dir}
+}
+
+func (fcs *FileCheckpointStore) Load(key string) (*ListingCheckpoint, error) {
+	data, err := ioutil.ReadFile(fcs.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the checkpoint %s: %v", key, err)
+	}
+
+	checkpoint := &ListingCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to decode the checkpoint %s: %v", key, err)
+	}
+
+	return checkpoint, nil
+}
+
+//Save replaces the file atomically, so a crash cannot leave a broken checkpoint
+func (fcs *FileCheckpointStore) Save(key string, checkpoint ListingCheckpoint) error {
+	if err := os.MkdirAll(fcs.Dir, 0700); err != nil {
+		return fmt.Errorf("failed to create the checkpoints directory %s: %v", fcs.Dir, err)
+	}
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	path := fcs.path(key)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return fmt.Errorf("failed to write the checkpoint %s: %v", key, err)
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+func (fcs *FileCheckpointStore) Delete(key string) error {
+	err := os.Remove(fcs.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete the checkpoint %s: %v", key, err)
+	}
+
+	return nil
+}
+
+func (fcs *FileCheckpointStore) path(key string) string {
+	return filepath.Join(fcs.Dir, checkpointFileNameReplacer.ReplaceAllString(key, "_")+".json")
+}
+
+//deriveCheckpointKey identifies a listing by its data provider and filters, the pagination filters are ignored
+func deriveCheckpointKey(dataProvider DataProvider, filters map[string]interface{}) string {
+	keyFilters := make(map[string]interface{}, len(filters))
+	for key, value := range filters {
+		if key == "recordsOnPage" || key == "pageNo" {
+			continue
+		}
+		keyFilters[key] = value
+	}
+
+	//maps are encoded with sorted keys, so the same filters give the same key
+	encodedFilters, err := json.Marshal(keyFilters)
+	if err != nil {
+		encodedFilters = []byte(fmt.Sprintf("%v", keyFilters))
+	}
+
+	hash := sha1.Sum(append([]byte(fmt.Sprintf("%T:", dataProvider)), encodedFilters...))
+	return "listing-" + hex.EncodeToString(hash[:])
+}
+
+//checkpointTracker records the progress of one Get call
+type checkpointTracker struct {
+	store      CheckpointStore
+	key        string
+	lock       sync.Mutex
+	checkpoint ListingCheckpoint
+	failed     bool
+}
+
+//resumeCheckpoint loads the progress of the previous run and gives the total count which the pages should be planned with
+func (p *Lister) resumeCheckpoint(key string, totalCount int) (*checkpointTracker, int) {
+	tracker := &checkpointTracker{
+		store:      p.checkpointStore,
+		key:        key,
+		checkpoint: ListingCheckpoint{TotalCount: totalCount},
+	}
+
+	saved, err := p.checkpointStore.Load(key)
+	if err != nil {
+		log.Log.Log(log.Warn, "failed to load the listing checkpoint %s, will list all items: %v", key, err)
+		return tracker, totalCount
+	}
+	if saved == nil {
+		return tracker, totalCount
+	}
+
+	if saved.TotalCount != totalCount {
+		log.Log.Log(
+			log.Warn,
+			"the total count of the listing %s changed from %d to %d since the checkpoint, the resumed listing uses the old pages and can skip or repeat items",
+			key,
+			saved.TotalCount,
+			totalCount,
+		)
+	}
+	log.Log.Log(log.Info, "will resume the listing %s from the checkpoint of %v", key, saved.UpdatedAt)
+	tracker.checkpoint = *saved
+
+	return tracker, saved.TotalCount
+}
+
+func (ct *checkpointTracker) isDone(cursor Cursor) bool {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	return ct.checkpoint.IsDone(cursor)
+}
+
+func (ct *checkpointTracker) markDone(cursors []Cursor) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	for _, cursor := range cursors {
+		ct.checkpoint.MarkDone(cursor)
+	}
+	ct.checkpoint.UpdatedAt = time.Now().UTC()
+
+	if err := ct.store.Save(ct.key, ct.checkpoint); err != nil {
+		log.Log.Log(log.Warn, "failed to save the listing checkpoint %s: %v", ct.key, err)
+	}
+}
+
+func (ct *checkpointTracker) fail() {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	ct.failed = true
+}
+
+//finish deletes the checkpoint once all items were delivered, so the next run starts from the beginning
+func (ct *checkpointTracker) finish(ctx context.Context, stopped bool) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	if ct.failed || stopped || ctx.Err() != nil {
+		return
+	}
+
+	if err := ct.store.Delete(ct.key); err != nil {
+		log.Log.Log(log.Warn, "failed to delete the finished listing checkpoint %s: %v", ct.key, err)
+	}
+}
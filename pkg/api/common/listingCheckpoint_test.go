@@ -0,0 +1,185 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"github.com/stretchr/testify/assert"
+)
+
+//pagedDataProviderMock gives the items 1..itemsCount by pages, reports total as the count and fails the first read of failPage
+type pagedDataProviderMock struct {
+	lock       sync.Mutex
+	itemsCount int
+	total      int
+	failPage   int
+	readPages  []int
+}
+
+func (pdp *pagedDataProviderMock) Count(ctx context.Context, filters map[string]interface{}) (int, error) {
+	pdp.lock.Lock()
+	defer pdp.lock.Unlock()
+
+	return pdp.total, nil
+}
+
+func (pdp *pagedDataProviderMock) Read(ctx context.Context, bulkFilters []map[string]interface{}, callback func(item interface{})) error {
+	pdp.lock.Lock()
+	defer pdp.lock.Unlock()
+
+	for _, bulkFilter := range bulkFilters {
+		page := bulkFilter["pageNo"].(int)
+		limit := bulkFilter["recordsOnPage"].(int)
+		if page == pdp.failPage {
+			pdp.failPage = 0
+			return fmt.Errorf("failed to read page %d", page)
+		}
+		pdp.readPages = append(pdp.readPages, page)
+
+		for id := (page-1)*limit + 1; id <= page*limit && id <= pdp.itemsCount; id++ {
+			callback(payloadMock{ID: id})
+		}
+	}
+
+	return nil
+}
+
+type logRecorder struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+func (lr *logRecorder) Log(t log.Type, message string, arguments ...interface{}) {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+	lr.messages = append(lr.messages, fmt.Sprintf(message, arguments...))
+}
+
+func collectIDs(itemsChan ItemsStream) (ids []int, err error) {
+	for item := range itemsChan {
+		if item.Err != nil {
+			err = item.Err
+			continue
+		}
+		ids = append(ids, item.Payload.(payloadMock).ID)
+	}
+
+	return ids, err
+}
+
+func TestListerResumesFromCheckpoint(t *testing.T) {
+	logger := &logRecorder{}
+	oldLogger := log.Log
+	log.Log = logger
+	defer func() {
+		log.Log = oldLogger
+	}()
+
+	store := NewMemoryCheckpointStore()
+	dp := &pagedDataProviderMock{itemsCount: 10, total: 10, failPage: 4}
+	newLister := func() *Lister {
+		lister := NewLister(ListingSettings{MaxItemsPerRequest: 2, StreamBufferLength: 5}, dp, NullSleeper)
+		lister.SetCheckpointStore(store, "")
+		return lister
+	}
+
+	ids, err := collectIDs(newLister().Get(context.Background(), map[string]interface{}{"changedSince": 100}))
+	assert.EqualError(t, err, "failed to read page 4")
+	//the lister continues with the next pages after a failed one
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 9, 10}, ids)
+
+	key := deriveCheckpointKey(dp, map[string]interface{}{"changedSince": 100})
+	checkpoint, err := store.Load(key)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, checkpoint.TotalCount)
+	assert.Equal(t, []CursorRange{{Limit: 2, From: 1, To: 3}, {Limit: 2, From: 5, To: 5}}, checkpoint.Done)
+
+	//other filters don't use the checkpoint
+	assert.NotEqual(t, key, deriveCheckpointKey(dp, map[string]interface{}{"changedSince": 200}))
+
+	//the new run reads only the failed page with the old total count
+	dp.total = 12
+	dp.readPages = nil
+	//the first run logged its failed page
+	logger.messages = nil
+	ids, err = collectIDs(newLister().Get(context.Background(), map[string]interface{}{"changedSince": 100}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 8}, ids)
+	assert.Equal(t, []int{4}, dp.readPages)
+	assert.Contains(t, logger.messages[0], "changed from 10 to 12")
+
+	checkpoint, err = store.Load(key)
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint, "the checkpoint of a finished listing should be deleted")
+}
+
+func TestDroppedItemsAreNotCheckpointed(t *testing.T) {
+	store := NewMemoryCheckpointStore()
+	dp := &pagedDataProviderMock{itemsCount: 4, total: 4}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2}, dp, NullSleeper)
+	run := newListingRun(4, map[string]interface{}{}, &checkpointTracker{store: store, key: "products"}, time.Now())
+
+	//the first item is dropped, so the page is not marked as done
+	var emitted []Item
+	ok := lister.fetchItemsFromAPI(context.Background(), []Cursor{{Limit: 2, Offset: 1}}, func(item Item) bool {
+		emitted = append(emitted, item)
+		return len(emitted) > 1
+	}, run)
+	assert.True(t, ok)
+	assert.Len(t, emitted, 2)
+
+	//the marker which is forwarded after the cancellation is ignored
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.True(t, lister.forwardItem(ctx, run, make(ItemsStream), Item{doneCursors: []Cursor{{Limit: 2, Offset: 1}}}))
+
+	checkpoint, err := store.Load("products")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+}
+
+func TestListingCheckpointMarkDone(t *testing.T) {
+	checkpoint := ListingCheckpoint{}
+	for _, offset := range []int{3, 1, 5, 2, 5} {
+		checkpoint.MarkDone(Cursor{Limit: 100, Offset: offset})
+	}
+	checkpoint.MarkDone(Cursor{Limit: 50, Offset: 4})
+
+	assert.Equal(t, []CursorRange{{Limit: 50, From: 4, To: 4}, {Limit: 100, From: 1, To: 3}, {Limit: 100, From: 5, To: 5}}, checkpoint.Done)
+	assert.True(t, checkpoint.IsDone(Cursor{Limit: 100, Offset: 2}))
+	assert.False(t, checkpoint.IsDone(Cursor{Limit: 100, Offset: 4}))
+	assert.False(t, checkpoint.IsDone(Cursor{Limit: 50, Offset: 2}))
+
+	checkpoint.MarkDone(Cursor{Limit: 100, Offset: 4})
+	assert.Equal(t, []CursorRange{{Limit: 50, From: 4, To: 4}, {Limit: 100, From: 1, To: 5}}, checkpoint.Done)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints"))
+
+	checkpoint, err := store.Load("products/changed")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	saved := ListingCheckpoint{TotalCount: 10, Done: []CursorRange{{Limit: 2, From: 1, To: 3}}}
+	assert.NoError(t, store.Save("products/changed", saved))
+
+	checkpoint, err = NewFileCheckpointStore(store.Dir).Load("products/changed")
+	assert.NoError(t, err)
+	assert.Equal(t, &saved, checkpoint)
+
+	assert.NoError(t, store.Delete("products/changed"))
+	assert.NoError(t, store.Delete("products/changed"))
+	checkpoint, err = store.Load("products/changed")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	checkpoint, err = NewFileCheckpointStore(filepath.Join(store.Dir, "missing")).Load("a")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+}
@@ -0,0 +1,247 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//ListingErrorPolicy tells the lister what to do when a page cannot be read
+type ListingErrorPolicy int
+
+const (
+	//ListingErrorSkipAndReport gives an error item for the failed page and continues with the next pages
+	ListingErrorSkipAndReport ListingErrorPolicy = iota
+	//ListingErrorFailFast gives an error item for the failed page and cancels the rest of the listing
+	ListingErrorFailFast
+	//ListingErrorRetryPage repeats the failed page according to ListingSettings.PageRetry,
+	//if all attempts fail the page is skipped and reported
+	ListingErrorRetryPage
+)
+
+func (lep ListingErrorPolicy) String() string {
+	switch lep {
+	case ListingErrorSkipAndReport:
+		return "skip-and-report"
+	case ListingErrorFailFast:
+		return "fail-fast"
+	case ListingErrorRetryPage:
+		return "retry-page"
+	default:
+		return fmt.Sprintf("ListingErrorPolicy(%d)", int(lep))
+	}
+}
+
+//ListingSummary is the outcome of a finished Get call, see Lister.Summary
+type ListingSummary struct {
+	TotalCount int
+	//DeliveredCount is the amount of items given to the consumer
+	DeliveredCount int
+	//Retries is the amount of repeated page reads
+	Retries int
+	//FailedCursors are the pages whose items were not delivered because of errors
+	FailedCursors []Cursor
+	//Errors are the errors which stopped the listing or the failed pages
+	Errors []error
+	//Cancelled is true if the listing was stopped by the context or by the fail-fast policy
+	Cancelled  bool
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+//Complete tells if all planned pages were delivered
+func (ls ListingSummary) Complete() bool {
+	return len(ls.FailedCursors) == 0 && len(ls.Errors) == 0 && !ls.Cancelled
+}
+
+//listingRun holds the state of one Get call which is shared by the fetchers
+type listingRun struct {
+	totalCount int
+	filters    map[string]interface{}
+	tracker    *checkpointTracker
+	//stopped is closed by the fail-fast policy, the context of the caller is not cancelled since the data provider gets it
+	stopped  chan struct{}
+	stopOnce sync.Once
+	//logger is taken when the listing starts, so the goroutines of the listing don't read the global logger
+	logger log.Logger
+	result *listingResult
+
+	lock    sync.Mutex
+	summary ListingSummary
+}
+
+func newListingRun(totalCount int, filters map[string]interface{}, tracker *checkpointTracker, startedAt time.Time) *listingRun {
+	return &listingRun{
+		totalCount: totalCount,
+		filters:    filters,
+		tracker:    tracker,
+		stopped:    make(chan struct{}),
+		logger:     log.Log,
+		summary:    ListingSummary{StartedAt: startedAt},
+	}
+}
+
+//stop makes the fetchers exit after their current page, the items of the page are dropped
+func (lr *listingRun) stop() {
+	lr.stopOnce.Do(func() {
+		close(lr.stopped)
+	})
+}
+
+func (lr *listingRun) isStopped() bool {
+	select {
+	case <-lr.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+func (lr *listingRun) addRetry() {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+
+	lr.summary.Retries++
+}
+
+func (lr *listingRun) addDelivered() {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+
+	lr.summary.DeliveredCount++
+}
+
+func (lr *listingRun) addFailure(cursors []Cursor, err error) {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+
+	lr.summary.FailedCursors = append(lr.summary.FailedCursors, cursors...)
+	lr.summary.Errors = append(lr.summary.Errors, err)
+}
+
+//finish completes the summary, it's called once all fetchers are stopped. An incomplete listing is logged,
+//so the missing items are noticed even if the caller doesn't check the summary
+func (lr *listingRun) finish(cancelled bool) ListingSummary {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+
+	lr.summary.TotalCount = lr.totalCount
+	lr.summary.Cancelled = cancelled
+	lr.summary.FinishedAt = time.Now().UTC()
+
+	if !lr.summary.Complete() {
+		failedPages := make([]int, 0, len(lr.summary.FailedCursors))
+		for _, cursor := range lr.summary.FailedCursors {
+			failedPages = append(failedPages, cursor.Offset)
+		}
+		lr.logger.Log(
+			log.Warn,
+			"listing finished incomplete with %d failed pages %v and %d errors, %d of %d items were delivered after %d retries, cancelled: %v",
+			len(failedPages),
+			failedPages,
+			len(lr.summary.Errors),
+			lr.summary.DeliveredCount,
+			lr.summary.TotalCount,
+			lr.summary.Retries,
+			lr.summary.Cancelled,
+		)
+	}
+
+	return lr.summary
+}
+
+//listingResult holds the summary of one Get call
+type listingResult struct {
+	lock    sync.Mutex
+	summary ListingSummary
+}
+
+func (lr *listingResult) get() ListingSummary {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+
+	return lr.summary
+}
+
+//Summary gives the outcome of the last finished Get call, it's available once the stream of the call is closed.
+//If several Get calls of the lister run concurrently, it's the summary of the one which finished last,
+//use GetWithSummary to get the summary of each call
+func (p *Lister) Summary() ListingSummary {
+	p.summaryLock.Lock()
+	defer p.summaryLock.Unlock()
+
+	return p.lastSummary
+}
+
+func (p *Lister) setSummary(result *listingResult, summary ListingSummary) {
+	if result != nil {
+		result.lock.Lock()
+		result.summary = summary
+		result.lock.Unlock()
+	}
+
+	p.summaryLock.Lock()
+	defer p.summaryLock.Unlock()
+
+	p.lastSummary = summary
+}
+
+//readPage calls the data provider and repeats the call on errors if the policy allows it. A page is repeated only if
+//the failed attempt gave no items, otherwise the consumer would get them twice
+func (p *Lister) readPage(
+	ctx context.Context,
+	run *listingRun,
+	cursors []Cursor,
+	bulkFilters []map[string]interface{},
+	callback func(item interface{}),
+) (itemsCount int, err error) {
+	retry := p.listingSettings.PageRetry
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			err = tracedThrottle(ctx, p.tracer, p.reqThrottler)
+			if err != nil {
+				return itemsCount, err
+			}
+		}
+
+		attemptItemsCount := 0
+		err = p.listingDataProvider.Read(ctx, bulkFilters, func(item interface{}) {
+			attemptItemsCount++
+			callback(item)
+		})
+		itemsCount += attemptItemsCount
+
+		if err == nil ||
+			p.listingSettings.ErrorPolicy != ListingErrorRetryPage ||
+			attemptItemsCount > 0 ||
+			attempt >= retry.AttemptsCount ||
+			!retry.ShouldRetry(nil, err) {
+			return itemsCount, err
+		}
+
+		backoff := retry.Backoff(attempt)
+		log.Log.Log(log.Debug, "failed to read the pages %v of the listing, attempt %d, will retry in %s: %v", cursors, attempt, backoff, err)
+		run.addRetry()
+		if !waitBackoff(ctx, run, backoff) {
+			return itemsCount, err
+		}
+	}
+}
+
+//waitBackoff waits till the next attempt of a page, it returns false if the listing was cancelled or stopped meanwhile
+func waitBackoff(ctx context.Context, run *listingRun, backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-run.stopped:
+		return false
+	}
+}
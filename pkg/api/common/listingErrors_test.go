@@ -0,0 +1,176 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListingErrorPolicies(t *testing.T) {
+	testCases := []struct {
+		name              string
+		settings          ListingSettings
+		expectedIDs       []int
+		expectedErr       string
+		expectedReadPages []int
+		expectedSummary   ListingSummary
+	}{
+		{
+			name:              "skip and report",
+			settings:          ListingSettings{},
+			expectedIDs:       []int{1, 2, 3, 4, 5, 6, 9, 10},
+			expectedErr:       "failed to read page 4",
+			expectedReadPages: []int{1, 2, 3, 5},
+			expectedSummary: ListingSummary{
+				TotalCount:     10,
+				DeliveredCount: 8,
+				FailedCursors:  []Cursor{{Limit: 2, Offset: 4}},
+			},
+		},
+		{
+			name: "retry page",
+			settings: ListingSettings{
+				ErrorPolicy: ListingErrorRetryPage,
+				PageRetry:   RetryPolicy{AttemptsCount: 3, InitialBackoff: time.Millisecond, RetryTransportErrors: true},
+			},
+			expectedIDs:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expectedReadPages: []int{1, 2, 3, 4, 5},
+			expectedSummary: ListingSummary{
+				TotalCount:     10,
+				DeliveredCount: 10,
+				Retries:        1,
+			},
+		},
+		{
+			name:              "fail fast",
+			settings:          ListingSettings{ErrorPolicy: ListingErrorFailFast},
+			expectedIDs:       []int{1, 2, 3, 4, 5, 6},
+			expectedErr:       "failed to read page 4",
+			expectedReadPages: []int{1, 2, 3},
+			expectedSummary: ListingSummary{
+				TotalCount:     10,
+				DeliveredCount: 6,
+				FailedCursors:  []Cursor{{Limit: 2, Offset: 4}},
+				Cancelled:      true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dp := &pagedDataProviderMock{itemsCount: 10, total: 10, failPage: 4}
+			settings := testCase.settings
+			settings.MaxItemsPerRequest = 2
+			lister := NewLister(settings, dp, NullSleeper)
+
+			ids, err := collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+			assert.Equal(t, testCase.expectedIDs, ids)
+			if testCase.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedErr)
+			}
+			assert.Equal(t, testCase.expectedReadPages, dp.readPages)
+
+			summary := lister.Summary()
+			assert.Equal(t, testCase.expectedSummary.TotalCount, summary.TotalCount)
+			assert.Equal(t, testCase.expectedSummary.DeliveredCount, summary.DeliveredCount)
+			assert.Equal(t, testCase.expectedSummary.Retries, summary.Retries)
+			assert.Equal(t, testCase.expectedSummary.FailedCursors, summary.FailedCursors)
+			assert.Equal(t, testCase.expectedSummary.Cancelled, summary.Cancelled)
+			assert.Equal(t, len(testCase.expectedSummary.FailedCursors), len(summary.Errors))
+			assert.Equal(t, testCase.expectedErr == "", summary.Complete())
+		})
+	}
+}
+
+func TestIncompleteListingIsLogged(t *testing.T) {
+	logger := &logRecorder{}
+	oldLogger := log.Log
+	log.Log = logger
+	defer func() {
+		log.Log = oldLogger
+	}()
+
+	dp := &pagedDataProviderMock{itemsCount: 10, total: 10, failPage: 4}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2}, dp, NullSleeper)
+
+	_, err := collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.EqualError(t, err, "failed to read page 4")
+	assert.Equal(t, []string{
+		"listing finished incomplete with 1 failed pages [4] and 1 errors, 8 of 10 items were delivered after 0 retries, cancelled: false",
+	}, logger.messages)
+
+	logger.messages = nil
+	_, err = collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Empty(t, logger.messages)
+}
+
+func TestListingRetryBackoffIsCancelled(t *testing.T) {
+	dp := &DataProviderMock{CountOutputCount: 2, ReadErrorStr: "some read items error"}
+	lister := NewLister(
+		ListingSettings{
+			ErrorPolicy: ListingErrorRetryPage,
+			PageRetry:   RetryPolicy{AttemptsCount: 3, InitialBackoff: time.Hour, RetryTransportErrors: true},
+		},
+		dp,
+		NullSleeper,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	itemsChan := lister.Get(ctx, map[string]interface{}{})
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	finished := make(chan struct{})
+	go func() {
+		for range itemsChan {
+		}
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("the backoff of the cancelled listing was not interrupted")
+	}
+
+	summary := lister.Summary()
+	assert.True(t, summary.Cancelled)
+	assert.Equal(t, 1, summary.Retries)
+}
+
+func TestListingSummaryPerCall(t *testing.T) {
+	dp := &pagedDataProviderMock{itemsCount: 10, total: 10, failPage: 4}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2}, dp, NullSleeper)
+
+	failedItems, failedSummary := lister.GetWithSummary(context.Background(), map[string]interface{}{})
+	_, err := collectIDs(failedItems)
+	assert.EqualError(t, err, "failed to read page 4")
+
+	items, summary := lister.GetWithSummary(context.Background(), map[string]interface{}{})
+	_, err = collectIDs(items)
+	assert.NoError(t, err)
+
+	//the later call replaces the summary of the lister, but not the one of the failed call
+	assert.True(t, lister.Summary().Complete())
+	assert.True(t, summary().Complete())
+	assert.False(t, failedSummary().Complete())
+	assert.Equal(t, []Cursor{{Limit: 2, Offset: 4}}, failedSummary().FailedCursors)
+}
+
+func TestListingSummaryOfFailedCount(t *testing.T) {
+	dp := &DataProviderMock{CountOutputErrorStr: "some count error"}
+	lister := NewLister(ListingSettings{ErrorPolicy: ListingErrorFailFast}, dp, NullSleeper)
+
+	_, err := collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.EqualError(t, err, "some count error")
+
+	summary := lister.Summary()
+	assert.False(t, summary.Complete())
+	assert.Len(t, summary.Errors, 1)
+	assert.Equal(t, "fail-fast", ListingErrorFailFast.String())
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+//getOrdered starts the fetchers of the ordered mode. The planned batches are queued in the page order, the queue length is
+//ReorderBufferLength, so the planning waits once the fetchers are too far ahead of the consumer
+func (p *Lister) getOrdered(ctx context.Context, span Span, run *listingRun) ItemsStream {
+	order := make(chan *cursorsBatch, p.listingSettings.ReorderBufferLength)
+	batchesChan := p.getCursors(ctx, run, order)
+
+	var fetchers sync.WaitGroup
+	fetchers.Add(p.listingSettings.MaxFetchersCount)
+	for i := 0; i < p.listingSettings.MaxFetchersCount; i++ {
+		go func() {
+			defer fetchers.Done()
+			p.fetchOrderedBatches(ctx, batchesChan, run)
+		}()
+	}
+
+	return p.mergeOrdered(ctx, span, run, order, &fetchers)
+}
+
+//fetchOrderedBatches keeps the items of every bulk request in its batch till the merger reaches it
+func (p *Lister) fetchOrderedBatches(ctx context.Context, batchesChan chan *cursorsBatch, run *listingRun) {
+	for batch := range batchesChan {
+		ok := p.fetchItemsFromAPI(ctx, batch.cursors, func(item Item) bool {
+			batch.items = append(batch.items, item)
+			return true
+		}, run)
+		close(batch.done)
+
+		if !ok && p.listingSettings.ErrorPolicy == ListingErrorFailFast {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-run.stopped:
+			return
+		default:
+			continue
+		}
+	}
+}
+
+//mergeOrdered gives the items of the batches to the consumer in the planned order
+func (p *Lister) mergeOrdered(ctx context.Context, span Span, run *listingRun, order <-chan *cursorsBatch, fetchers *sync.WaitGroup) ItemsStream {
+	parentChan := p.newOutputChan(run)
+
+	go func() {
+	batches:
+		for batch := range order {
+			//the batches which were not fetched because of the cancellation are never done
+			select {
+			case <-batch.done:
+			case <-ctx.Done():
+				break batches
+			case <-run.stopped:
+				break batches
+			}
+
+			for _, item := range batch.items {
+				if !p.forwardItem(ctx, run, parentChan, item) {
+					break batches
+				}
+			}
+		}
+
+		fetchers.Wait()
+		p.finishListing(ctx, span, run, parentChan)
+	}()
+
+	return parentChan
+}
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//slowFirstPagesProviderMock reads the pages concurrently, the first pages take the longest time
+type slowFirstPagesProviderMock struct {
+	itemsCount int
+	lock       sync.Mutex
+	readsCount int
+}
+
+func (sfp *slowFirstPagesProviderMock) Count(ctx context.Context, filters map[string]interface{}) (int, error) {
+	return sfp.itemsCount, nil
+}
+
+func (sfp *slowFirstPagesProviderMock) Read(ctx context.Context, bulkFilters []map[string]interface{}, callback func(item interface{})) error {
+	sfp.lock.Lock()
+	sfp.readsCount++
+	sfp.lock.Unlock()
+
+	for _, bulkFilter := range bulkFilters {
+		page := bulkFilter["pageNo"].(int)
+		limit := bulkFilter["recordsOnPage"].(int)
+		time.Sleep(time.Duration(sfp.itemsCount/limit-page) * 2 * time.Millisecond)
+
+		for id := (page-1)*limit + 1; id <= page*limit && id <= sfp.itemsCount; id++ {
+			callback(payloadMock{ID: id})
+		}
+	}
+
+	return nil
+}
+
+func (sfp *slowFirstPagesProviderMock) getReadsCount() int {
+	sfp.lock.Lock()
+	defer sfp.lock.Unlock()
+
+	return sfp.readsCount
+}
+
+func expectedIDs(count int) []int {
+	ids := make([]int, 0, count)
+	for id := 1; id <= count; id++ {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func TestOrderedListing(t *testing.T) {
+	dp := &slowFirstPagesProviderMock{itemsCount: 20}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2, MaxFetchersCount: 4, StreamBufferLength: 3, Ordered: true}, dp, NullSleeper)
+
+	ids, err := collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIDs(20), ids)
+	assert.Equal(t, 20, lister.Summary().DeliveredCount)
+	assert.Equal(t, 8, lister.listingSettings.ReorderBufferLength)
+}
+
+func TestOrderedListingBuffersLimitedAmountOfRequests(t *testing.T) {
+	dp := &slowFirstPagesProviderMock{itemsCount: 40}
+	lister := NewLister(
+		ListingSettings{MaxItemsPerRequest: 2, MaxFetchersCount: 3, Ordered: true, ReorderBufferLength: 2},
+		dp,
+		NullSleeper,
+	)
+
+	itemsChan := lister.Get(context.Background(), map[string]interface{}{})
+	firstItem := <-itemsChan
+	assert.Equal(t, 1, firstItem.Payload.(payloadMock).ID)
+
+	//the request given to the consumer and the ones in the reorder buffer
+	assert.Eventually(t, func() bool {
+		return dp.getReadsCount() == 3
+	}, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 3, dp.getReadsCount())
+
+	ids, err := collectIDs(itemsChan)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIDs(40)[1:], ids)
+}
+
+func TestOrderedListingFailFast(t *testing.T) {
+	dp := &pagedDataProviderMock{itemsCount: 20, total: 20, failPage: 4}
+	lister := NewLister(
+		ListingSettings{MaxItemsPerRequest: 2, MaxFetchersCount: 3, Ordered: true, ErrorPolicy: ListingErrorFailFast},
+		dp,
+		NullSleeper,
+	)
+
+	ids, err := collectIDs(lister.Get(context.Background(), map[string]interface{}{}))
+	assert.EqualError(t, err, "failed to read page 4")
+	//the pages before the failed one are delivered even if they are fetched later
+	assert.Equal(t, expectedIDs(6), ids)
+
+	summary := lister.Summary()
+	assert.True(t, summary.Cancelled)
+	assert.Equal(t, 6, summary.DeliveredCount)
+	assert.Equal(t, []Cursor{{Limit: 2, Offset: 4}}, summary.FailedCursors)
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"time"
+)
+
+//Metrics receives the measurements of the API calls, the implementations should be safe for concurrent use.
+//MetricsRegistry is the built-in implementation, other monitoring systems can be connected by implementing this interface
+type Metrics interface {
+	//RequestDone is called after every HTTP call to the API including the repeated ones, errorCode is the code
+	//of the response status and err is set if the call failed without an API response
+	RequestDone(apiMethod string, bulk bool, duration time.Duration, errorCode ApiError, err error)
+	//BulkSubRequestDone is called for every sub-request of a bulk call with the code of its status
+	BulkSubRequestDone(apiMethod string, errorCode ApiError)
+	//RetryScheduled is called when a failed call will be repeated by the retry policy
+	RetryScheduled(apiMethod string)
+	//ThrottleWaited is called with the time which a call waited for the throttler
+	ThrottleWaited(duration time.Duration)
+	//SessionRefreshed is called when a new session key was obtained from the API
+	SessionRefreshed()
+}
+
+//NoopMetrics ignores all measurements
+type NoopMetrics struct{}
+
+func (NoopMetrics) RequestDone(apiMethod string, bulk bool, duration time.Duration, errorCode ApiError, err error) {
+}
+
+func (NoopMetrics) BulkSubRequestDone(apiMethod string, errorCode ApiError) {}
+
+func (NoopMetrics) RetryScheduled(apiMethod string) {}
+
+func (NoopMetrics) ThrottleWaited(duration time.Duration) {}
+
+func (NoopMetrics) SessionRefreshed() {}
+
+//MetricsInterceptor gives an interceptor which measures API calls, it should be the innermost one
+//so that every attempt is measured without the time spent in throttling or quota waits
+func MetricsInterceptor(metrics Metrics) Interceptor {
+	return func(ctx context.Context, call *RequestCall, next RequestHandler) (*RequestResult, error) {
+		start := time.Now()
+		res, err := next(ctx, call)
+		duration := time.Since(start)
+
+		var errorCode ApiError
+		if res != nil && res.Status != nil {
+			errorCode = res.Status.ErrorCode
+		}
+		metrics.RequestDone(metricsMethodName(call), call.IsBulk(), duration, errorCode, err)
+
+		if res != nil && call.IsBulk() {
+			for i, bulkRequest := range call.BulkRequests {
+				var subErrorCode ApiError
+				if i < len(res.BulkStatuses) {
+					subErrorCode = res.BulkStatuses[i].ErrorCode
+				}
+				metrics.BulkSubRequestDone(bulkRequest.MethodName, subErrorCode)
+			}
+		}
+
+		return res, err
+	}
+}
+
+//metricsMethodName gives the API method name of the call, bulk calls with different methods are named "bulk"
+func metricsMethodName(call *RequestCall) string {
+	methodName := call.MethodName()
+	if methodName == "" {
+		return "bulk"
+	}
+
+	return methodName
+}
@@ -0,0 +1,243 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//DefaultMetricsNamespace is the prefix of the metric names of MetricsRegistry
+const DefaultMetricsNamespace = "erply_api"
+
+//DefaultLatencyBuckets are the upper bounds in seconds of the request duration histogram
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+//MetricsRegistry is a Metrics implementation which keeps the counters in memory and serves them in the
+//Prometheus text exposition format, so it can be scraped without the Prometheus client library:
+//
+//	registry := common.NewMetricsRegistry("", nil)
+//	http.Handle("/metrics", registry)
+type MetricsRegistry struct {
+	namespace       string
+	buckets         []float64
+	lock            sync.Mutex
+	requests        map[string]float64
+	latencies       map[string]*histogram
+	apiErrors       map[string]float64
+	bulkSubRequests map[string]float64
+	retries         map[string]float64
+	throttleWait    float64
+	throttleCount   float64
+	sessionRefresh  float64
+}
+
+type histogram struct {
+	counts []float64
+	count  float64
+	sum    float64
+}
+
+//NewMetricsRegistry creates a registry, DefaultMetricsNamespace and DefaultLatencyBuckets are used for empty arguments
+func NewMetricsRegistry(namespace string, latencyBuckets []float64) *MetricsRegistry {
+	if namespace == "" {
+		namespace = DefaultMetricsNamespace
+	}
+	if len(latencyBuckets) == 0 {
+		latencyBuckets = DefaultLatencyBuckets
+	}
+	buckets := make([]float64, len(latencyBuckets))
+	copy(buckets, latencyBuckets)
+	sort.Float64s(buckets)
+
+	return &MetricsRegistry{
+		namespace:       namespace,
+		buckets:         buckets,
+		requests:        map[string]float64{},
+		latencies:       map[string]*histogram{},
+		apiErrors:       map[string]float64{},
+		bulkSubRequests: map[string]float64{},
+		retries:         map[string]float64{},
+	}
+}
+
+//RequestDone implements Metrics
+func (mr *MetricsRegistry) RequestDone(apiMethod string, bulk bool, duration time.Duration, errorCode ApiError, err error) {
+	result := "ok"
+	if err != nil {
+		result = "transport_error"
+	} else if errorCode != 0 {
+		result = "api_error"
+	}
+	bulkLabel := strconv.FormatBool(bulk)
+
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.requests[labels("method", apiMethod, "bulk", bulkLabel, "result", result)]++
+
+	latencyKey := labels("method", apiMethod, "bulk", bulkLabel)
+	h, ok := mr.latencies[latencyKey]
+	if !ok {
+		h = &histogram{counts: make([]float64, len(mr.buckets))}
+		mr.latencies[latencyKey] = h
+	}
+	h.observe(mr.buckets, duration.Seconds())
+
+	if errorCode != 0 {
+		mr.apiErrors[labels("method", apiMethod, "code", strconv.Itoa(int(errorCode)))]++
+	}
+}
+
+//BulkSubRequestDone implements Metrics
+func (mr *MetricsRegistry) BulkSubRequestDone(apiMethod string, errorCode ApiError) {
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.bulkSubRequests[labels("method", apiMethod)]++
+	if errorCode != 0 {
+		mr.apiErrors[labels("method", apiMethod, "code", strconv.Itoa(int(errorCode)))]++
+	}
+}
+
+//RetryScheduled implements Metrics
+func (mr *MetricsRegistry) RetryScheduled(apiMethod string) {
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.retries[labels("method", apiMethod)]++
+}
+
+//ThrottleWaited implements Metrics
+func (mr *MetricsRegistry) ThrottleWaited(duration time.Duration) {
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.throttleWait += duration.Seconds()
+	mr.throttleCount++
+}
+
+//SessionRefreshed implements Metrics
+func (mr *MetricsRegistry) SessionRefreshed() {
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.sessionRefresh++
+}
+
+//ServeHTTP writes the metrics in the Prometheus text format
+func (mr *MetricsRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = mr.WriteTo(w)
+}
+
+//WriteTo writes the metrics in the Prometheus text format
+func (mr *MetricsRegistry) WriteTo(w io.Writer) (int64, error) {
+	buf := &bytes.Buffer{}
+
+	mr.lock.Lock()
+	mr.writeCounter(buf, "requests_total", "Amount of HTTP calls to the API.", mr.requests)
+	mr.writeHistogram(buf, "request_duration_seconds", "Duration of HTTP calls to the API.")
+	mr.writeCounter(buf, "error_codes_total", "Amount of error codes in the API responses including bulk sub-requests.", mr.apiErrors)
+	mr.writeCounter(buf, "bulk_subrequests_total", "Amount of sub-requests sent in bulk calls.", mr.bulkSubRequests)
+	mr.writeCounter(buf, "retries_total", "Amount of repeated API calls.", mr.retries)
+	mr.writeCounter(buf, "throttle_wait_seconds_total", "Time spent waiting for the throttler.", map[string]float64{"": mr.throttleWait})
+	mr.writeCounter(buf, "throttle_waits_total", "Amount of API calls which passed the throttler.", map[string]float64{"": mr.throttleCount})
+	mr.writeCounter(buf, "session_refreshes_total", "Amount of new session keys obtained from the API.", map[string]float64{"": mr.sessionRefresh})
+	mr.lock.Unlock()
+
+	return buf.WriteTo(w)
+}
+
+func (mr *MetricsRegistry) writeCounter(buf *bytes.Buffer, name, help string, values map[string]float64) {
+	fullName := mr.namespace + "_" + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s counter\n", fullName, help, fullName)
+	for _, key := range sortedKeys(values) {
+		fmt.Fprintf(buf, "%s%s %s\n", fullName, wrapLabels(key), formatFloat(values[key]))
+	}
+}
+
+func (mr *MetricsRegistry) writeHistogram(buf *bytes.Buffer, name, help string) {
+	fullName := mr.namespace + "_" + name
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s histogram\n", fullName, help, fullName)
+
+	keys := make([]string, 0, len(mr.latencies))
+	for key := range mr.latencies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		h := mr.latencies[key]
+		for i, bound := range mr.buckets {
+			bucketLabels := joinLabels(key, labels("le", formatFloat(bound)))
+			fmt.Fprintf(buf, "%s_bucket%s %s\n", fullName, wrapLabels(bucketLabels), formatFloat(h.counts[i]))
+		}
+		fmt.Fprintf(buf, "%s_bucket%s %s\n", fullName, wrapLabels(joinLabels(key, labels("le", "+Inf"))), formatFloat(h.count))
+		fmt.Fprintf(buf, "%s_sum%s %s\n", fullName, wrapLabels(key), formatFloat(h.sum))
+		fmt.Fprintf(buf, "%s_count%s %s\n", fullName, wrapLabels(key), formatFloat(h.count))
+	}
+}
+
+//observe adds the value to the histogram, the bucket counts are cumulative like in the exposition format
+func (h *histogram) observe(buckets []float64, value float64) {
+	for i, bound := range buckets {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+//labels builds the label pairs from the names and values, e.g. method="getProducts",bulk="false"
+func labels(namesAndValues ...string) string {
+	pairs := make([]string, 0, len(namesAndValues)/2)
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		pairs = append(pairs, namesAndValues[i]+`="`+escapeLabelValue(namesAndValues[i+1])+`"`)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func joinLabels(first, second string) string {
+	if first == "" {
+		return second
+	}
+	if second == "" {
+		return first
+	}
+
+	return first + "," + second
+}
+
+func wrapLabels(pairs string) string {
+	if pairs == "" {
+		return ""
+	}
+
+	return "{" + pairs + "}"
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func sortedKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
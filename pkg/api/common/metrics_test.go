@@ -0,0 +1,90 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsInterceptors(t *testing.T) {
+	registry := NewMetricsRegistry("", []float64{1, 10})
+
+	outcomes := []*RequestResult{statusResult(200, ServerMaintenance), statusResult(200, 0)}
+	attempt := 0
+	handler := ChainInterceptors(
+		func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+			res := outcomes[attempt]
+			attempt++
+			return res, nil
+		},
+		NewMeasuredRetryInterceptor(RetryPolicies{Default: RetryPolicy{AttemptsCount: 3, InitialBackoff: time.Millisecond}}, registry),
+		MeasuredThrottleInterceptor(&ThrottlerMock{}, registry),
+		MetricsInterceptor(registry),
+	)
+	_, err := handler(context.Background(), &RequestCall{ApiMethod: "getProducts"})
+	assert.NoError(t, err)
+
+	bulkHandler := ChainInterceptors(
+		func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+			return &RequestResult{
+				Status: &Status{ResponseStatus: "ok"},
+				BulkStatuses: []StatusBulk{
+					{Status: Status{ResponseStatus: "ok"}},
+					{Status: Status{ResponseStatus: "error", ErrorCode: InvalidValue}},
+				},
+			}, nil
+		},
+		MetricsInterceptor(registry),
+	)
+	_, err = bulkHandler(context.Background(), &RequestCall{BulkRequests: []BulkInput{
+		{MethodName: "getProducts"},
+		{MethodName: "getCustomers"},
+	}})
+	assert.NoError(t, err)
+
+	failingHandler := ChainInterceptors(
+		func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+			return nil, errors.New("connection refused")
+		},
+		MetricsInterceptor(registry),
+	)
+	_, err = failingHandler(context.Background(), &RequestCall{ApiMethod: "getCustomers"})
+	assert.Error(t, err)
+
+	registry.SessionRefreshed()
+
+	rec := httptest.NewRecorder()
+	registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+
+	body := rec.Body.String()
+	for _, expectedLine := range []string{
+		"# TYPE erply_api_requests_total counter",
+		`erply_api_requests_total{method="getProducts",bulk="false",result="api_error"} 1`,
+		`erply_api_requests_total{method="getProducts",bulk="false",result="ok"} 1`,
+		`erply_api_requests_total{method="bulk",bulk="true",result="ok"} 1`,
+		`erply_api_requests_total{method="getCustomers",bulk="false",result="transport_error"} 1`,
+		"# TYPE erply_api_request_duration_seconds histogram",
+		`erply_api_request_duration_seconds_bucket{method="getProducts",bulk="false",le="1"} 2`,
+		`erply_api_request_duration_seconds_bucket{method="getProducts",bulk="false",le="+Inf"} 2`,
+		`erply_api_request_duration_seconds_count{method="getProducts",bulk="false"} 2`,
+		`erply_api_error_codes_total{method="getProducts",code="1000"} 1`,
+		`erply_api_error_codes_total{method="getCustomers",code="1016"} 1`,
+		`erply_api_bulk_subrequests_total{method="getProducts"} 1`,
+		`erply_api_bulk_subrequests_total{method="getCustomers"} 1`,
+		`erply_api_retries_total{method="getProducts"} 1`,
+		"erply_api_throttle_waits_total 2",
+		"erply_api_session_refreshes_total 1",
+	} {
+		assert.Contains(t, body, expectedLine+"\n")
+	}
+}
+
+func TestMetricsLabelsEscaping(t *testing.T) {
+	assert.Equal(t, `method="a\"b\\c\nd"`, labels("method", "a\"b\\c\nd"))
+}
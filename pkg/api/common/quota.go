@@ -0,0 +1,139 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//DefaultHourlyRequestsQuota is the default amount of requests per hour which Erply allows for an account
+const DefaultHourlyRequestsQuota = 2000
+
+//QuotaMode defines what happens when the hourly quota is used up
+type QuotaMode int
+
+const (
+	//QuotaModeTrackOnly just counts requests and lets them through
+	QuotaModeTrackOnly QuotaMode = iota
+	//QuotaModeBlock waits till the quota is reset before sending a request
+	QuotaModeBlock
+	//QuotaModeFailFast returns a HourlyRequestQuota error without sending a request
+	QuotaModeFailFast
+)
+
+type QuotaSettings struct {
+	//HourlyLimit is the amount of requests per hour, DefaultHourlyRequestsQuota is used if it's not set
+	HourlyLimit int
+	//Reserve is the amount of requests which are kept aside e.g. for a POS integration, the limit is hit when only Reserve requests are left
+	Reserve int
+	Mode    QuotaMode
+}
+
+//QuotaTracker counts requests against the hourly quota of an account, a bulk request counts as one
+type QuotaTracker struct {
+	settings QuotaSettings
+	lock     sync.Mutex
+	used     int
+	resetAt  time.Time
+	now      func() time.Time
+}
+
+func NewQuotaTracker(settings QuotaSettings) *QuotaTracker {
+	if settings.HourlyLimit <= 0 {
+		settings.HourlyLimit = DefaultHourlyRequestsQuota
+	}
+
+	return &QuotaTracker{
+		settings: settings,
+		now:      time.Now,
+	}
+}
+
+//Remaining gives the amount of requests left till the end of the current hour
+func (qt *QuotaTracker) Remaining() int {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	qt.rollOver()
+
+	return qt.settings.HourlyLimit - qt.used
+}
+
+//ResetAt gives the time when the quota will be reset
+func (qt *QuotaTracker) ResetAt() time.Time {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	qt.rollOver()
+
+	return qt.resetAt
+}
+
+//Acquire counts one request, depending on the mode it waits or fails if the quota is used up
+func (qt *QuotaTracker) Acquire(ctx context.Context) error {
+	for {
+		qt.lock.Lock()
+		qt.rollOver()
+		if qt.used < qt.settings.HourlyLimit-qt.settings.Reserve || qt.settings.Mode == QuotaModeTrackOnly {
+			qt.used++
+			qt.lock.Unlock()
+			return nil
+		}
+		resetAt := qt.resetAt
+		waitTime := resetAt.Sub(qt.now())
+		qt.lock.Unlock()
+
+		if qt.settings.Mode == QuotaModeFailFast {
+			return NewErplyError(
+				"Error",
+				fmt.Sprintf("hourly request quota of %d requests is used up till %s", qt.settings.HourlyLimit, resetAt.Format(time.RFC3339)),
+				HourlyRequestQuota,
+			)
+		}
+
+		log.Log.Log(log.Warn, "hourly request quota is used up, will wait %v till it's reset", waitTime)
+		if !sleepContext(ctx, waitTime) {
+			return ctx.Err()
+		}
+	}
+}
+
+//MarkExhausted syncs the tracker with the API after it reported that the quota is exceeded
+func (qt *QuotaTracker) MarkExhausted() {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	qt.rollOver()
+	qt.used = qt.settings.HourlyLimit
+}
+
+//Interceptor gives an interceptor which counts every API call with this tracker
+func (qt *QuotaTracker) Interceptor() Interceptor {
+	return func(ctx context.Context, call *RequestCall, next RequestHandler) (*RequestResult, error) {
+		if err := qt.Acquire(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, call)
+		if res != nil && res.Status != nil && res.Status.ErrorCode == HourlyRequestQuota {
+			log.Log.Log(log.Warn, "API reported that the hourly request quota is exceeded")
+			qt.MarkExhausted()
+		}
+
+		return res, err
+	}
+}
+
+//rollOver starts a new hour if needed, should be called under lock
+func (qt *QuotaTracker) rollOver() {
+	now := qt.now()
+	if now.Before(qt.resetAt) {
+		return
+	}
+
+	qt.used = 0
+	qt.resetAt = now.Truncate(time.Hour).Add(time.Hour)
+}
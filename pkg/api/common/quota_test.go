@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaTrackerCounting(t *testing.T) {
+	now := time.Date(2020, 5, 1, 10, 30, 0, 0, time.UTC)
+	qt := NewQuotaTracker(QuotaSettings{HourlyLimit: 3, Mode: QuotaModeFailFast})
+	qt.now = func() time.Time {
+		return now
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, qt.Acquire(context.Background()))
+	}
+	assert.Equal(t, 0, qt.Remaining())
+	assert.Equal(t, time.Date(2020, 5, 1, 11, 0, 0, 0, time.UTC), qt.ResetAt())
+
+	err := qt.Acquire(context.Background())
+	assert.Error(t, err)
+	if err == nil {
+		return
+	}
+	assert.Equal(t, HourlyRequestQuota, err.(*ErplyError).Code)
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, 3, qt.Remaining())
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.Equal(t, time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC), qt.ResetAt())
+}
+
+func TestQuotaTrackerReserveAndTrackOnlyMode(t *testing.T) {
+	qt := NewQuotaTracker(QuotaSettings{HourlyLimit: 3, Reserve: 1, Mode: QuotaModeFailFast})
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.Error(t, qt.Acquire(context.Background()))
+
+	qt = NewQuotaTracker(QuotaSettings{HourlyLimit: 1})
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.Equal(t, -1, qt.Remaining())
+}
+
+func TestQuotaTrackerBlocking(t *testing.T) {
+	qt := NewQuotaTracker(QuotaSettings{HourlyLimit: 1, Mode: QuotaModeBlock})
+	realNow := time.Now()
+	offset := realNow.Truncate(time.Hour).Add(time.Hour).Add(-50 * time.Millisecond).Sub(realNow)
+	qt.now = func() time.Time {
+		return time.Now().Add(offset)
+	}
+
+	assert.NoError(t, qt.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, qt.Acquire(ctx))
+
+	startedAt := time.Now()
+	assert.NoError(t, qt.Acquire(context.Background()))
+	assert.True(t, time.Since(startedAt) > 10*time.Millisecond)
+}
+
+func TestQuotaTrackerInterceptor(t *testing.T) {
+	qt := NewQuotaTracker(QuotaSettings{HourlyLimit: 10, Mode: QuotaModeFailFast})
+	callsCount := 0
+	handler := ChainInterceptors(func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+		callsCount++
+		return statusResult(200, HourlyRequestQuota), nil
+	}, qt.Interceptor())
+
+	_, err := handler(context.Background(), &RequestCall{ApiMethod: "getProducts"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, qt.Remaining())
+
+	_, err = handler(context.Background(), &RequestCall{ApiMethod: "getProducts"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, callsCount)
+}
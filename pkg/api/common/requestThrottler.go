@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -10,9 +11,13 @@ type Throttler interface {
 	Throttle()
 }
 
-type Sleeper func(sleepTime time.Duration)
+//ContextThrottler is a Throttler which stops waiting once the context is cancelled
+type ContextThrottler interface {
+	Throttler
+	ThrottleContext(ctx context.Context) error
+}
 
-var sleepThrottler *SleepThrottler
+type Sleeper func(sleepTime time.Duration)
 
 //SleepThrottler implements sleeping logic for requests throttling
 type SleepThrottler struct {
@@ -23,19 +28,15 @@ type SleepThrottler struct {
 	lock           sync.Mutex
 }
 
-//NewSleepThrottler creates SleepThrottler
+//NewSleepThrottler creates SleepThrottler, to share the limit between multiple listers give them the same instance with SetRequestThrottler
 func NewSleepThrottler(limitPerSecond int, sl Sleeper) *SleepThrottler {
-	if sleepThrottler == nil {
-		sleepThrottler = &SleepThrottler{
-			LimitPerSecond: limitPerSecond,
-			LastTimestamp:  time.Now().Unix(),
-			Count:          0,
-			sl:             sl,
-			lock:           sync.Mutex{},
-		}
+	return &SleepThrottler{
+		LimitPerSecond: limitPerSecond,
+		LastTimestamp:  time.Now().Unix(),
+		Count:          0,
+		sl:             sl,
+		lock:           sync.Mutex{},
 	}
-
-	return sleepThrottler
 }
 
 //Throttle implements throttling method
@@ -60,6 +61,98 @@ func (rt *SleepThrottler) Throttle() {
 	}
 }
 
+//TokenBucketThrottler allows bursts of requests and refills the allowed amount with a constant rate,
+//use one instance per account to share its limit between the lister, the managers and the bulk calls
+type TokenBucketThrottler struct {
+	limitPerSecond float64
+	burst          float64
+	tokens         float64
+	lastRefill     time.Time
+	lock           sync.Mutex
+	now            func() time.Time
+}
+
+//NewTokenBucketThrottler creates TokenBucketThrottler, a limit below or equal 0 disables throttling
+func NewTokenBucketThrottler(limitPerSecond float64, burst int) *TokenBucketThrottler {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucketThrottler{
+		limitPerSecond: limitPerSecond,
+		burst:          float64(burst),
+		tokens:         float64(burst),
+		lastRefill:     time.Now(),
+		now:            time.Now,
+	}
+}
+
+//Throttle waits till a request is allowed
+func (tbt *TokenBucketThrottler) Throttle() {
+	_ = tbt.ThrottleContext(context.Background())
+}
+
+//ThrottleContext waits till a request is allowed or the context is cancelled
+func (tbt *TokenBucketThrottler) ThrottleContext(ctx context.Context) error {
+	if tbt.limitPerSecond <= 0 {
+		return ctx.Err()
+	}
+
+	tbt.lock.Lock()
+	now := tbt.now()
+	tbt.tokens += now.Sub(tbt.lastRefill).Seconds() * tbt.limitPerSecond
+	if tbt.tokens > tbt.burst {
+		tbt.tokens = tbt.burst
+	}
+	tbt.lastRefill = now
+
+	//the token is reserved immediately, so concurrent callers are queued one after another
+	tbt.tokens--
+	waitTime := time.Duration(-tbt.tokens / tbt.limitPerSecond * float64(time.Second))
+	tbt.lock.Unlock()
+
+	if waitTime <= 0 {
+		return nil
+	}
+
+	if !sleepContext(ctx, waitTime) {
+		tbt.lock.Lock()
+		tbt.tokens++
+		tbt.lock.Unlock()
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+//ThrottleInterceptor gives an interceptor which calls the throttler before every API call
+func ThrottleInterceptor(thrl Throttler) Interceptor {
+	return MeasuredThrottleInterceptor(thrl, NoopMetrics{})
+}
+
+//MeasuredThrottleInterceptor gives a throttle interceptor which reports the waiting time to metrics
+func MeasuredThrottleInterceptor(thrl Throttler, metrics Metrics) Interceptor {
+	return func(ctx context.Context, call *RequestCall, next RequestHandler) (*RequestResult, error) {
+		start := time.Now()
+		err := throttle(ctx, thrl)
+		metrics.ThrottleWaited(time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, call)
+	}
+}
+
+func throttle(ctx context.Context, thrl Throttler) error {
+	if ctxThrl, ok := thrl.(ContextThrottler); ok {
+		return ctxThrl.ThrottleContext(ctx)
+	}
+
+	thrl.Throttle()
+	return nil
+}
+
 type ThrottlerMock struct {
 	WasTriggered bool
 }
@@ -67,3 +160,8 @@ type ThrottlerMock struct {
 func (tm *ThrottlerMock) Throttle() {
 	tm.WasTriggered = true
 }
+
+func (tm *ThrottlerMock) ThrottleContext(ctx context.Context) error {
+	tm.WasTriggered = true
+	return ctx.Err()
+}
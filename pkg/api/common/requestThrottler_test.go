@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSleepThrottlerIsNotShared(t *testing.T) {
+	thrl1 := NewSleepThrottler(1, NullSleeper)
+	thrl2 := NewSleepThrottler(5, NullSleeper)
+
+	assert.False(t, thrl1 == thrl2)
+	assert.Equal(t, 1, thrl1.LimitPerSecond)
+	assert.Equal(t, 5, thrl2.LimitPerSecond)
+}
+
+func TestTokenBucketThrottlerBurst(t *testing.T) {
+	now := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	thrl := NewTokenBucketThrottler(10, 3)
+	thrl.lastRefill = now
+	thrl.now = func() time.Time {
+		return now
+	}
+
+	for i := 0; i < 3; i++ {
+		startedAt := time.Now()
+		assert.NoError(t, thrl.ThrottleContext(context.Background()))
+		assert.True(t, time.Since(startedAt) < 50*time.Millisecond)
+	}
+
+	startedAt := time.Now()
+	assert.NoError(t, thrl.ThrottleContext(context.Background()))
+	assert.True(t, time.Since(startedAt) >= 90*time.Millisecond)
+
+	//the bucket is refilled after a second but not more than the burst size
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 3; i++ {
+		startedAt := time.Now()
+		thrl.Throttle()
+		assert.True(t, time.Since(startedAt) < 50*time.Millisecond)
+	}
+}
+
+func TestTokenBucketThrottlerCancellation(t *testing.T) {
+	thrl := NewTokenBucketThrottler(0.1, 1)
+	assert.NoError(t, thrl.ThrottleContext(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	startedAt := time.Now()
+	err := thrl.ThrottleContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(startedAt) < time.Second)
+}
+
+func TestTokenBucketThrottlerUnlimited(t *testing.T) {
+	thrl := NewTokenBucketThrottler(0, 1)
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, thrl.ThrottleContext(context.Background()))
+	}
+}
+
+func TestListerStopsOnCancelledThrottling(t *testing.T) {
+	dataProvider := &DataProviderMock{CountOutputCount: 10}
+	lister := NewLister(ListingSettings{}, dataProvider, NullSleeper)
+	lister.SetRequestThrottler(NewTokenBucketThrottler(0.1, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	items := collectProdsFromChannel(lister.Get(ctx, map[string]interface{}{}))
+	assert.Len(t, items, 0)
+	assert.Len(t, dataProvider.ReadBulkFilters, 0)
+}
@@ -0,0 +1,189 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//ErrorClass groups API errors by the way the client should react on them
+type ErrorClass int
+
+const (
+	//ErrorClassFatal errors won't disappear if the request is repeated
+	ErrorClassFatal ErrorClass = iota
+	//ErrorClassRetryable errors are temporary, the request can be repeated after some time
+	ErrorClassRetryable
+	//ErrorClassSession errors mean that the request should be repeated with a new session
+	ErrorClassSession
+)
+
+const (
+	DefaultRetryAttemptsCount  = 4
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
+	DefaultRetryMultiplier     = 2
+	DefaultRetryJitter         = 0.5
+)
+
+//ClassifyApiError tells if the request which failed with the given error code can be retried
+func ClassifyApiError(code ApiError) ErrorClass {
+	switch code {
+	case ServerMaintenance, AccountDbConnError, DbError, SameInstanceIsRunning:
+		return ErrorClassRetryable
+	case APISessionExpired, InvalidSession, SessionTooOld:
+		return ErrorClassSession
+	default:
+		return ErrorClassFatal
+	}
+}
+
+//RetryPolicy describes how failed requests are repeated. Be careful with enabling retries for
+//non idempotent requests like save calls, since a transport error doesn't mean that the data was not saved
+type RetryPolicy struct {
+	//AttemptsCount is the total amount of attempts including the first one, values below 2 disable retries
+	AttemptsCount int
+	//InitialBackoff is the waiting time before the first retry
+	InitialBackoff time.Duration
+	//MaxBackoff caps the waiting time between attempts
+	MaxBackoff time.Duration
+	//Multiplier increases the waiting time after each attempt
+	Multiplier float64
+	//Jitter is the fraction of the waiting time which is randomly subtracted, values are between 0 and 1
+	Jitter float64
+	//RetryTransportErrors enables retries when the HTTP request itself failed
+	RetryTransportErrors bool
+	//RetryServerErrors enables retries on HTTP 5xx responses
+	RetryServerErrors bool
+}
+
+//DefaultRetryPolicy gives a policy which retries temporary Erply errors, transport errors and HTTP 5xx responses
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		AttemptsCount:        DefaultRetryAttemptsCount,
+		InitialBackoff:       DefaultRetryInitialBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		Multiplier:           DefaultRetryMultiplier,
+		Jitter:               DefaultRetryJitter,
+		RetryTransportErrors: true,
+		RetryServerErrors:    true,
+	}
+}
+
+//Backoff gives the waiting time after the failed attempt, attempts are counted from 1
+func (rp RetryPolicy) Backoff(attempt int) time.Duration {
+	multiplier := rp.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultRetryMultiplier
+	}
+
+	backoff := float64(rp.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if rp.MaxBackoff > 0 && backoff > float64(rp.MaxBackoff) {
+		backoff = float64(rp.MaxBackoff)
+	}
+
+	if rp.Jitter > 0 {
+		jitter := math.Min(rp.Jitter, 1)
+		backoff -= backoff * jitter * rand.Float64()
+	}
+
+	return time.Duration(backoff)
+}
+
+//ShouldRetry tells if the outcome of an attempt is worth repeating
+func (rp RetryPolicy) ShouldRetry(res *RequestResult, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var erplyErr *ErplyError
+		if errors.As(err, &erplyErr) {
+			if erplyErr.HTTPStatus >= http.StatusInternalServerError {
+				return rp.RetryServerErrors
+			}
+			if erplyErr.Code != 0 {
+				return ClassifyApiError(erplyErr.Code) == ErrorClassRetryable
+			}
+		}
+		return rp.RetryTransportErrors
+	}
+
+	if res == nil {
+		return false
+	}
+
+	if res.Response != nil && res.Response.StatusCode >= http.StatusInternalServerError {
+		return rp.RetryServerErrors
+	}
+
+	if res.Status != nil && res.Status.ErrorCode != 0 {
+		return ClassifyApiError(res.Status.ErrorCode) == ErrorClassRetryable
+	}
+
+	return false
+}
+
+//RetryPolicies holds the default retry policy and its overrides per API method name
+type RetryPolicies struct {
+	Default   RetryPolicy
+	PerMethod map[string]RetryPolicy
+}
+
+//PolicyFor gives the policy for the call, bulk calls are matched by the method name of their sub-requests
+func (rp RetryPolicies) PolicyFor(call *RequestCall) RetryPolicy {
+	if policy, ok := rp.PerMethod[call.MethodName()]; ok {
+		return policy
+	}
+
+	return rp.Default
+}
+
+//NewRetryInterceptor creates an interceptor which repeats failed calls according to the policies
+func NewRetryInterceptor(policies RetryPolicies) Interceptor {
+	return NewMeasuredRetryInterceptor(policies, NoopMetrics{})
+}
+
+//NewMeasuredRetryInterceptor creates a retry interceptor which reports every scheduled retry to metrics
+func NewMeasuredRetryInterceptor(policies RetryPolicies, metrics Metrics) Interceptor {
+	return func(ctx context.Context, call *RequestCall, next RequestHandler) (*RequestResult, error) {
+		policy := policies.PolicyFor(call)
+
+		var res *RequestResult
+		var err error
+		for attempt := 1; ; attempt++ {
+			res, err = next(ctx, call)
+			if attempt >= policy.AttemptsCount || !policy.ShouldRetry(res, err) {
+				return res, err
+			}
+
+			backoff := policy.Backoff(attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+				log.Log.Log(log.Debug, "won't retry %s since the context deadline is earlier than the next attempt", call.MethodName())
+				return res, err
+			}
+
+			log.Log.Log(log.Debug, "will retry %s after %v, attempt %d of %d", call.MethodName(), backoff, attempt+1, policy.AttemptsCount)
+			metrics.RetryScheduled(metricsMethodName(call))
+			if !sleepContext(ctx, backoff) {
+				return res, err
+			}
+		}
+	}
+}
+
+func sleepContext(ctx context.Context, dur time.Duration) bool {
+	timer := time.NewTimer(dur)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
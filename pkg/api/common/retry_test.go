@@ -0,0 +1,151 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyApiError(t *testing.T) {
+	for _, code := range []ApiError{ServerMaintenance, AccountDbConnError, DbError, SameInstanceIsRunning} {
+		assert.Equal(t, ErrorClassRetryable, ClassifyApiError(code), code.String())
+	}
+	for _, code := range []ApiError{APISessionExpired, InvalidSession, SessionTooOld} {
+		assert.Equal(t, ErrorClassSession, ClassifyApiError(code), code.String())
+	}
+	for _, code := range []ApiError{MalformedRequest, HourlyRequestQuota, LoginFailed} {
+		assert.Equal(t, ErrorClassFatal, ClassifyApiError(code), code.String())
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+	}
+
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 4*time.Second, policy.Backoff(3))
+	assert.Equal(t, 5*time.Second, policy.Backoff(4))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := policy.Backoff(4)
+		assert.True(t, backoff <= 5*time.Second && backoff >= 2500*time.Millisecond, backoff)
+	}
+}
+
+func statusResult(httpCode int, errCode ApiError) *RequestResult {
+	status := &Status{ResponseStatus: "ok", ErrorCode: errCode}
+	if errCode != 0 {
+		status.ResponseStatus = "error"
+	}
+	return &RequestResult{
+		Response: &http.Response{StatusCode: httpCode},
+		Status:   status,
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	policy := RetryPolicy{
+		AttemptsCount:        3,
+		InitialBackoff:       time.Millisecond,
+		RetryTransportErrors: true,
+		RetryServerErrors:    true,
+	}
+
+	testCases := []struct {
+		name             string
+		outcomes         []*RequestResult
+		errs             []error
+		expectedAttempts int
+		expectedCode     ApiError
+	}{
+		{
+			name:             "retryable error code",
+			outcomes:         []*RequestResult{statusResult(200, ServerMaintenance), statusResult(200, DbError), statusResult(200, 0)},
+			errs:             []error{nil, nil, nil},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "fatal error code",
+			outcomes:         []*RequestResult{statusResult(200, MalformedRequest), statusResult(200, 0)},
+			errs:             []error{nil, nil},
+			expectedAttempts: 1,
+			expectedCode:     MalformedRequest,
+		},
+		{
+			name:             "attempts exhausted",
+			outcomes:         []*RequestResult{statusResult(200, DbError), statusResult(502, 0), statusResult(200, AccountDbConnError)},
+			errs:             []error{nil, nil, nil},
+			expectedAttempts: 3,
+			expectedCode:     AccountDbConnError,
+		},
+		{
+			name:             "transport error",
+			outcomes:         []*RequestResult{nil, statusResult(200, 0)},
+			errs:             []error{errors.New("connection reset"), nil},
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			attempts := 0
+			handler := ChainInterceptors(func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+				res, err := testCase.outcomes[attempts], testCase.errs[attempts]
+				attempts++
+				return res, err
+			}, NewRetryInterceptor(RetryPolicies{Default: policy}))
+
+			res, err := handler(context.Background(), &RequestCall{ApiMethod: "getProducts"})
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedAttempts, attempts)
+			assert.Equal(t, testCase.expectedCode, res.Status.ErrorCode)
+		})
+	}
+}
+
+func TestRetryInterceptorPerMethodPolicy(t *testing.T) {
+	attempts := 0
+	handler := ChainInterceptors(func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+		attempts++
+		return statusResult(200, ServerMaintenance), nil
+	}, NewRetryInterceptor(RetryPolicies{
+		Default: RetryPolicy{AttemptsCount: 3},
+		PerMethod: map[string]RetryPolicy{
+			"saveProduct": {AttemptsCount: 1},
+		},
+	}))
+
+	_, err := handler(context.Background(), &RequestCall{BulkRequests: []BulkInput{{MethodName: "saveProduct"}, {MethodName: "saveProduct"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	_, err = handler(context.Background(), &RequestCall{ApiMethod: "getProducts"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryInterceptorRespectsDeadline(t *testing.T) {
+	attempts := 0
+	handler := ChainInterceptors(func(ctx context.Context, call *RequestCall) (*RequestResult, error) {
+		attempts++
+		return statusResult(200, ServerMaintenance), nil
+	}, NewRetryInterceptor(RetryPolicies{Default: RetryPolicy{AttemptsCount: 5, InitialBackoff: time.Minute}}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := handler(ctx, &RequestCall{ApiMethod: "getProducts"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, ServerMaintenance, res.Status.ErrorCode)
+}
@@ -14,5 +14,7 @@ type Status struct {
 type StatusBulk struct {
 	RequestName string `json:"requestName"`
 	RequestID   string `json:"requestID"`
+	//SendError is set by the client if the API didn't answer the sub-request because its part of a split bulk call failed
+	SendError string `json:"sendError,omitempty"`
 	Status
 }
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"context"
+)
+
+//Span names of the library
+const (
+	SpanSendRequest     = "erply.SendRequest"
+	SpanSendRequestBulk = "erply.SendRequestBulk"
+	SpanListerGet       = "erply.Lister.Get"
+	SpanListerCount     = "erply.Lister.Count"
+	SpanListerPage      = "erply.Lister.FetchPage"
+	SpanThrottle        = "erply.Throttle"
+)
+
+//Span attribute keys of the library
+const (
+	AttrMethod          = "erply.method"
+	AttrClientCode      = "erply.client_code"
+	AttrBulkSubRequests = "erply.bulk.subrequests"
+	AttrErrorCode       = "erply.error_code"
+	AttrHTTPStatusCode  = "http.status_code"
+	AttrTotalCount      = "erply.lister.total_count"
+	AttrPageFrom        = "erply.lister.page_from"
+	AttrPageTo          = "erply.lister.page_to"
+	AttrItemsCount      = "erply.lister.items_count"
+)
+
+//Tracer starts spans, it can be adapted to OpenTelemetry or another tracing library.
+//The parent span should be taken from the context, and the returned context should carry the new span
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+//Span is a traced operation
+type Span interface {
+	SetAttribute(key string, value interface{})
+	//RecordError marks the span as failed
+	RecordError(err error)
+	End()
+}
+
+//NoopTracer creates spans which do nothing
+type NoopTracer struct{}
+
+func (NoopTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+
+func (noopSpan) RecordError(err error) {}
+
+func (noopSpan) End() {}
+
+//SetResultAttributes sets the HTTP status code and the API error code of the call result on the span
+func SetResultAttributes(span Span, res *RequestResult, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	if res == nil {
+		return
+	}
+
+	if res.Response != nil {
+		span.SetAttribute(AttrHTTPStatusCode, res.Response.StatusCode)
+	}
+	if res.Status != nil && res.Status.ErrorCode != 0 {
+		span.SetAttribute(AttrErrorCode, int(res.Status.ErrorCode))
+	}
+}
+
+//tracedThrottle waits for the throttler in a child span
+func tracedThrottle(ctx context.Context, tracer Tracer, thrl Throttler) error {
+	_, span := tracer.StartSpan(ctx, SpanThrottle)
+	defer span.End()
+
+	err := throttle(ctx, thrl)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
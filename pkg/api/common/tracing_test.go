@@ -0,0 +1,136 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type spanKey struct{}
+
+type recordedSpan struct {
+	name       string
+	parent     *recordedSpan
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+	lock       *sync.Mutex
+}
+
+func (rs *recordedSpan) SetAttribute(key string, value interface{}) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	rs.attributes[key] = value
+}
+
+func (rs *recordedSpan) RecordError(err error) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	rs.err = err
+}
+
+func (rs *recordedSpan) End() {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	rs.ended = true
+}
+
+type recordingTracer struct {
+	lock  sync.Mutex
+	spans []*recordedSpan
+}
+
+func (rt *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	parent, _ := ctx.Value(spanKey{}).(*recordedSpan)
+	span := &recordedSpan{name: name, parent: parent, attributes: map[string]interface{}{}, lock: &rt.lock}
+	rt.spans = append(rt.spans, span)
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (rt *recordingTracer) byName(name string) []*recordedSpan {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	var res []*recordedSpan
+	for _, span := range rt.spans {
+		if span.name == name {
+			res = append(res, span)
+		}
+	}
+	return res
+}
+
+func TestListerTracing(t *testing.T) {
+	tracer := &recordingTracer{}
+	dataProvider := &DataProviderMock{
+		CountOutputCount: 300,
+		ProductsToRead:   []payloadMock{{ID: 1}, {ID: 2}},
+	}
+
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 100, MaxFetchersCount: 2}, dataProvider, NullSleeper)
+	lister.SetTracer(tracer)
+
+	count := 0
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+		count++
+	}
+	assert.Equal(t, 6, count)
+
+	listSpans := tracer.byName(SpanListerGet)
+	assert.Len(t, listSpans, 1)
+	listSpan := listSpans[0]
+	assert.True(t, listSpan.ended)
+	assert.Equal(t, 300, listSpan.attributes[AttrTotalCount])
+
+	countSpans := tracer.byName(SpanListerCount)
+	assert.Len(t, countSpans, 1)
+	assert.Equal(t, listSpan, countSpans[0].parent)
+
+	pageSpans := tracer.byName(SpanListerPage)
+	assert.Len(t, pageSpans, 3)
+	pages := map[interface{}]bool{}
+	for _, pageSpan := range pageSpans {
+		assert.Equal(t, listSpan, pageSpan.parent)
+		assert.True(t, pageSpan.ended)
+		assert.Equal(t, 2, pageSpan.attributes[AttrItemsCount])
+		pages[pageSpan.attributes[AttrPageFrom]] = true
+	}
+	assert.Equal(t, map[interface{}]bool{1: true, 2: true, 3: true}, pages)
+
+	throttleSpans := tracer.byName(SpanThrottle)
+	assert.Len(t, throttleSpans, 4)
+	pageChildren := 0
+	for _, throttleSpan := range throttleSpans {
+		if throttleSpan.parent != listSpan {
+			assert.Equal(t, SpanListerPage, throttleSpan.parent.name)
+			pageChildren++
+		}
+	}
+	assert.Equal(t, 3, pageChildren)
+}
+
+func TestListerTracingWithReadError(t *testing.T) {
+	tracer := &recordingTracer{}
+	dataProvider := &DataProviderMock{
+		CountOutputCount: 10,
+		ReadErrorStr:     "some read error",
+	}
+
+	lister := NewLister(ListingSettings{}, dataProvider, NullSleeper)
+	lister.SetTracer(tracer)
+
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.EqualError(t, item.Err, "some read error")
+	}
+
+	pageSpans := tracer.byName(SpanListerPage)
+	assert.Len(t, pageSpans, 1)
+	assert.EqualError(t, pageSpans[0].err, "some read error")
+}
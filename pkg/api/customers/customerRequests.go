@@ -124,10 +124,10 @@ func (cli *Client) GetCustomersBulk(ctx context.Context, bulkFilters []map[strin
 		return customersResponse, sharedCommon.NewErplyError(customersResponse.Status.ErrorCode.String(), customersResponse.Status.Request+": "+customersResponse.Status.ResponseStatus, customersResponse.Status.ErrorCode)
 	}
 
-	for _, supplierBulkItem := range customersResponse.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return customersResponse, sharedCommon.NewErplyError(supplierBulkItem.Status.ErrorCode.String(), supplierBulkItem.Status.Request+": "+supplierBulkItem.Status.ResponseStatus, customersResponse.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(customersResponse.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return customersResponse.BulkItems[i].Status
+	}); err != nil {
+		return customersResponse, err
 	}
 
 	return customersResponse, nil
@@ -229,10 +229,10 @@ func (cli *Client) AddCustomerRewardPointsBulk(ctx context.Context, bulkFilters
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -241,10 +241,6 @@ func (cli *Client) AddCustomerRewardPointsBulk(ctx context.Context, bulkFilters
 func (cli *Client) SaveCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (SaveCustomerResponseBulk, error) {
 	var saveCustomerResponseBulk SaveCustomerResponseBulk
 
-	if len(customerMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveCustomerResponseBulk, fmt.Errorf("cannot save more than %d customers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(customerMap))
 	for _, customer := range customerMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -271,14 +267,10 @@ func (cli *Client) SaveCustomerBulk(ctx context.Context, customerMap []map[strin
 		return saveCustomerResponseBulk, sharedCommon.NewErplyError(saveCustomerResponseBulk.Status.ErrorCode.String(), saveCustomerResponseBulk.Status.Request+": "+saveCustomerResponseBulk.Status.ResponseStatus, saveCustomerResponseBulk.Status.ErrorCode)
 	}
 
-	for _, bulkItem := range saveCustomerResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return saveCustomerResponseBulk, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkItem.Status),
-				saveCustomerResponseBulk.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(saveCustomerResponseBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return saveCustomerResponseBulk.BulkItems[i].Status
+	}); err != nil {
+		return saveCustomerResponseBulk, err
 	}
 
 	return saveCustomerResponseBulk, nil
@@ -305,10 +297,6 @@ func (cli *Client) DeleteCustomer(ctx context.Context, filters map[string]string
 func (cli *Client) DeleteCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (DeleteCustomersResponseBulk, error) {
 	var deleteCustomersResponse DeleteCustomersResponseBulk
 
-	if len(customerMap) > sharedCommon.MaxBulkRequestsCount {
-		return deleteCustomersResponse, fmt.Errorf("cannot delete more than %d customers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(customerMap))
 	for _, filter := range customerMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -339,14 +327,10 @@ func (cli *Client) DeleteCustomerBulk(ctx context.Context, customerMap []map[str
 		)
 	}
 
-	for _, bulkItem := range deleteCustomersResponse.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return deleteCustomersResponse, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkItem.Status),
-				deleteCustomersResponse.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(deleteCustomersResponse.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return deleteCustomersResponse.BulkItems[i].Status
+	}); err != nil {
+		return deleteCustomersResponse, err
 	}
 
 	return deleteCustomersResponse, nil
@@ -0,0 +1,110 @@
+package customers
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//CustomersIterator gives the customers of a listing one by one
+type CustomersIterator struct {
+	*sharedCommon.ItemsIterator
+	value Customer
+}
+
+//IterateCustomers lists the customers matching the filters with CustomerListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateCustomers(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *CustomersIterator {
+	lister := sharedCommon.NewLister(settings, NewCustomerListingDataProvider(erplyClient), time.Sleep)
+	return NewCustomersIterator(ctx, lister, filters)
+}
+
+//NewCustomersIterator iterates over the items of a lister which was created with NewCustomerListingDataProvider
+func NewCustomersIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *CustomersIterator {
+	return &CustomersIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *CustomersIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(Customer)
+	if !ok {
+		it.FailUnexpectedPayload("customers.Customer")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *CustomersIterator) Value() Customer {
+	return it.value
+}
+
+//ForEachCustomer calls f for every item of IterateCustomers, the iteration stops at the first error of the listing or f
+func ForEachCustomer(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(customer Customer) error) error {
+	it := IterateCustomers(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//SuppliersIterator gives the suppliers of a listing one by one
+type SuppliersIterator struct {
+	*sharedCommon.ItemsIterator
+	value Supplier
+}
+
+//IterateSuppliers lists the suppliers matching the filters with SupplierListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateSuppliers(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *SuppliersIterator {
+	lister := sharedCommon.NewLister(settings, NewSupplierListingDataProvider(erplyClient), time.Sleep)
+	return NewSuppliersIterator(ctx, lister, filters)
+}
+
+//NewSuppliersIterator iterates over the items of a lister which was created with NewSupplierListingDataProvider
+func NewSuppliersIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *SuppliersIterator {
+	return &SuppliersIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *SuppliersIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(Supplier)
+	if !ok {
+		it.FailUnexpectedPayload("customers.Supplier")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *SuppliersIterator) Value() Supplier {
+	return it.value
+}
+
+//ForEachSupplier calls f for every item of IterateSuppliers, the iteration stops at the first error of the listing or f
+func ForEachSupplier(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(supplier Supplier) error) error {
+	it := IterateSuppliers(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
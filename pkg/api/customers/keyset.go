@@ -0,0 +1,26 @@
+package customers
+
+import (
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//CustomersKeyset pages the customers by their lastModified time with the changedSince filter, see Lister.SetKeysetPagination
+func CustomersKeyset() *sharedCommon.KeysetPagination {
+	return &sharedCommon.KeysetPagination{
+		KeyFilter:    "changedSince",
+		OrderFilters: map[string]interface{}{"orderBy": "changed", "orderByDir": "asc"},
+		ItemKey: func(item interface{}) (int64, int, bool) {
+			customer, ok := item.(Customer)
+			return int64(customer.LastModified), customer.CustomerID, ok
+		},
+	}
+}
+
+//NewCustomersKeysetLister creates a lister of NewCustomerListingDataProvider which uses CustomersKeyset instead of the offset pages
+func NewCustomersKeysetLister(erplyClient Manager, settings sharedCommon.ListingSettings) *sharedCommon.Lister {
+	lister := sharedCommon.NewLister(settings, NewCustomerListingDataProvider(erplyClient), time.Sleep)
+	lister.SetKeysetPagination(CustomersKeyset())
+	return lister
+}
@@ -52,10 +52,10 @@ func (cli *Client) GetSuppliersBulk(ctx context.Context, bulkFilters []map[strin
 		return suppliersResp, sharedCommon.NewErplyError(suppliersResp.Status.ErrorCode.String(), suppliersResp.Status.Request+": "+suppliersResp.Status.ResponseStatus, suppliersResp.Status.ErrorCode)
 	}
 
-	for _, supplierBulkItem := range suppliersResp.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return suppliersResp, sharedCommon.NewErplyError(supplierBulkItem.Status.ErrorCode.String(), supplierBulkItem.Status.Request+": "+supplierBulkItem.Status.ResponseStatus, suppliersResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(suppliersResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return suppliersResp.BulkItems[i].Status
+	}); err != nil {
+		return suppliersResp, err
 	}
 
 	return suppliersResp, nil
@@ -85,10 +85,6 @@ func (cli *Client) SaveSupplier(ctx context.Context, filters map[string]string)
 func (cli *Client) SaveSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (SaveSuppliersResponseBulk, error) {
 	var saveSuppliersResponseBulk SaveSuppliersResponseBulk
 
-	if len(supplierMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveSuppliersResponseBulk, fmt.Errorf("cannot save more than %d suppliers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(supplierMap))
 	for _, supplier := range supplierMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -119,14 +115,10 @@ func (cli *Client) SaveSupplierBulk(ctx context.Context, supplierMap []map[strin
 		)
 	}
 
-	for _, supplierBulkItem := range saveSuppliersResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return saveSuppliersResponseBulk, sharedCommon.NewErplyError(
-				supplierBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", supplierBulkItem.Status),
-				supplierBulkItem.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(saveSuppliersResponseBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return saveSuppliersResponseBulk.BulkItems[i].Status
+	}); err != nil {
+		return saveSuppliersResponseBulk, err
 	}
 
 	return saveSuppliersResponseBulk, nil
@@ -151,10 +143,6 @@ func (cli *Client) DeleteSupplier(ctx context.Context, filters map[string]string
 func (cli *Client) DeleteSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (DeleteSuppliersResponseBulk, error) {
 	var deleteSupplierResponse DeleteSuppliersResponseBulk
 
-	if len(supplierMap) > sharedCommon.MaxBulkRequestsCount {
-		return deleteSupplierResponse, fmt.Errorf("cannot delete more than %d suppliers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(supplierMap))
 	for _, filter := range supplierMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -185,14 +173,10 @@ func (cli *Client) DeleteSupplierBulk(ctx context.Context, supplierMap []map[str
 		)
 	}
 
-	for _, supplierBulkItem := range deleteSupplierResponse.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return deleteSupplierResponse, sharedCommon.NewErplyError(
-				supplierBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", supplierBulkItem.Status),
-				deleteSupplierResponse.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(deleteSupplierResponse.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return deleteSupplierResponse.BulkItems[i].Status
+	}); err != nil {
+		return deleteSupplierResponse, err
 	}
 
 	return deleteSupplierResponse, nil
@@ -0,0 +1,59 @@
+package documents
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//PurchaseDocumentsIterator gives the purchase documents of a listing one by one
+type PurchaseDocumentsIterator struct {
+	*sharedCommon.ItemsIterator
+	value PurchaseDocument
+}
+
+//IteratePurchaseDocuments lists the purchase documents matching the filters with ListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IteratePurchaseDocuments(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *PurchaseDocumentsIterator {
+	lister := sharedCommon.NewLister(settings, NewListingDataProvider(erplyClient), time.Sleep)
+	return NewPurchaseDocumentsIterator(ctx, lister, filters)
+}
+
+//NewPurchaseDocumentsIterator iterates over the items of a lister which was created with NewListingDataProvider
+func NewPurchaseDocumentsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *PurchaseDocumentsIterator {
+	return &PurchaseDocumentsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *PurchaseDocumentsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(PurchaseDocument)
+	if !ok {
+		it.FailUnexpectedPayload("documents.PurchaseDocument")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *PurchaseDocumentsIterator) Value() PurchaseDocument {
+	return it.value
+}
+
+//ForEachPurchaseDocument calls f for every item of IteratePurchaseDocuments, the iteration stops at the first error of the listing or f
+func ForEachPurchaseDocument(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(document PurchaseDocument) error) error {
+	it := IteratePurchaseDocuments(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
@@ -0,0 +1,472 @@
+//Package erplytest provides an in-memory fake of the ERPLY API for tests. The Server emulates single requests
+//which are dispatched by the "request" parameter, bulk requests in the "requests" parameter, paging with
+//recordsOnPage/pageNo, session keys and API errors. Data stores for the common entities can be seeded with the models of this library.
+package erplytest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/internal/common"
+	"github.com/erply/api-go-wrapper/pkg/api"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+const (
+	DefaultClientCode    = "123456"
+	DefaultSessionKey    = "erplytest-session"
+	DefaultUserName      = "erplytest"
+	DefaultPassword      = "erplytest"
+	DefaultSessionLen    = 3600
+	DefaultRecordsOnPage = 20
+	MaxRecordsOnPage     = 1000
+)
+
+//Params are the parameters of a single request or a bulk sub-request
+type Params map[string]string
+
+//Int gives the parameter as integer or the default value if it is missing or invalid
+func (p Params) Int(key string, defaultValue int) int {
+	v, err := strconv.Atoi(p[key])
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
+
+//Handler serves an API method, it gives the records of the response and the total amount of matching records,
+//a returned or wrapped *sharedCommon.ErplyError is converted to an error status with its code and error field
+type Handler func(params Params) (records interface{}, recordsTotal int, err error)
+
+type failure struct {
+	method     string
+	code       sharedCommon.ApiError
+	errorField string
+	times      int
+}
+
+//Server is a fake ERPLY API server
+type Server struct {
+	*httptest.Server
+	ClientCode string
+	//SessionKey is a valid session key which is created with the server
+	SessionKey string
+
+	Products       *Store
+	Customers      *Store
+	Suppliers      *Store
+	Addresses      *Store
+	Warehouses     *Store
+	SalesDocuments *Store
+	Payments       *Store
+	PriceLists     *Store
+
+	lock       sync.Mutex
+	handlers   map[string]Handler
+	sessions   map[string]time.Time
+	users      map[string]string
+	failures   []*failure
+	calls      map[string]int
+	httpCalls  int
+	sessionSeq int
+	now        func() time.Time
+}
+
+//NewServer starts a fake API server with empty stores, a valid DefaultSessionKey and a user with DefaultUserName and DefaultPassword,
+//it should be closed after the test
+func NewServer() *Server {
+	s := &Server{
+		ClientCode: DefaultClientCode,
+		SessionKey: DefaultSessionKey,
+		handlers:   map[string]Handler{},
+		sessions:   map[string]time.Time{},
+		users:      map[string]string{DefaultUserName: DefaultPassword},
+		calls:      map[string]int{},
+		now:        time.Now,
+	}
+	s.sessions[DefaultSessionKey] = s.now().Add(DefaultSessionLen * time.Second)
+
+	s.Products = s.NewStore(ProductsStoreConfig)
+	s.Customers = s.NewStore(CustomersStoreConfig)
+	s.Suppliers = s.NewStore(SuppliersStoreConfig)
+	s.Addresses = s.NewStore(AddressesStoreConfig)
+	s.Warehouses = s.NewStore(WarehousesStoreConfig)
+	s.SalesDocuments = s.NewStore(SalesDocumentsStoreConfig)
+	s.Payments = s.NewStore(PaymentsStoreConfig)
+	s.PriceLists = s.NewStore(PriceListsStoreConfig)
+
+	s.Handle("verifyUser", s.verifyUser)
+	s.Handle("getSessionKeyInfo", s.getSessionKeyInfo)
+	s.Handle("getSessionKeyUser", s.getSessionKeyUser)
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+//NewClient creates an API client which sends requests to the server with the valid session key
+func (s *Server) NewClient() (*api.Client, error) {
+	return api.NewClientWithURL(s.SessionKey, s.ClientCode, "", s.URL, s.HTTPClient(), nil)
+}
+
+//HTTPClient gives a client which sends all requests to the server regardless of their URL,
+//so also the requests to the production URLs like the ones from the auth package reach the fake
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: redirectTransport{target: s.URL, next: s.Client().Transport},
+		Timeout:   10 * time.Second,
+	}
+}
+
+type redirectTransport struct {
+	target string
+	next   http.RoundTripper
+}
+
+func (rt redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(rt.target)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	req.Host = target.Host
+
+	return rt.next.RoundTrip(req)
+}
+
+//Handle registers a handler for the API method, it replaces the existing handler of the method
+func (s *Server) Handle(method string, handler Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[method] = handler
+}
+
+//AddUser registers credentials which are accepted by verifyUser
+func (s *Server) AddUser(userName, password string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.users[userName] = password
+}
+
+//AddSession registers a session key which is valid for the given duration
+func (s *Server) AddSession(sessionKey string, validFor time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions[sessionKey] = s.now().Add(validFor)
+}
+
+//ExpireSession makes the session key invalid, the next requests with it fail with APISessionExpired
+func (s *Server) ExpireSession(sessionKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.sessions, sessionKey)
+}
+
+//FailNext makes the next times calls of the API method fail with the error code, use an empty method to fail any call
+func (s *Server) FailNext(method string, code sharedCommon.ApiError, errorField string, times int) {
+	if times < 1 {
+		times = 1
+	}
+	s.addFailure(&failure{method: method, code: code, errorField: errorField, times: times})
+}
+
+//FailAlways makes all calls of the API method fail with the error code until ClearFailures is called
+func (s *Server) FailAlways(method string, code sharedCommon.ApiError, errorField string) {
+	s.addFailure(&failure{method: method, code: code, errorField: errorField, times: -1})
+}
+
+func (s *Server) addFailure(f *failure) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failures = append(s.failures, f)
+}
+
+//ClearFailures removes all injected errors
+func (s *Server) ClearFailures() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failures = nil
+}
+
+//Calls gives how many times the API method was called, both as a single request and as a bulk sub-request
+func (s *Server) Calls(method string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.calls[method]
+}
+
+//HTTPCalls gives the amount of HTTP requests the server got
+func (s *Server) HTTPCalls() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.httpCalls
+}
+
+type bulkItemResponse struct {
+	Status  sharedCommon.StatusBulk `json:"status"`
+	Records interface{}             `json:"records"`
+}
+
+type response struct {
+	Status   sharedCommon.Status `json:"status"`
+	Records  interface{}         `json:"records,omitempty"`
+	Requests []bulkItemResponse  `json:"requests,omitempty"`
+}
+
+//ServeHTTP handles a single or a bulk API request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.httpCalls++
+	s.lock.Unlock()
+
+	filters, err := common.ExtractBulkFiltersFromRequest(r)
+	if err != nil {
+		s.writeResponse(w, response{Status: s.errorStatus("", sharedCommon.MalformedRequest, "requests")})
+		return
+	}
+
+	params := Params{}
+	for key, value := range filters {
+		if str, ok := value.(string); ok {
+			params[key] = str
+		}
+	}
+
+	bulkRequests, _ := filters["requests"].([]map[string]interface{})
+	method := params["request"]
+	if method == "" && len(bulkRequests) == 0 {
+		s.writeResponse(w, response{Status: s.errorStatus("", sharedCommon.UnknownApi, "request")})
+		return
+	}
+
+	if code, field := s.checkAuth(method, params); code != 0 {
+		s.writeResponse(w, response{Status: s.errorStatus(method, code, field)})
+		return
+	}
+
+	if method != "" {
+		records, status := s.call(method, params)
+		s.writeResponse(w, response{Status: status, Records: records})
+		return
+	}
+
+	if len(bulkRequests) > sharedCommon.MaxBulkRequestsCount {
+		s.writeResponse(w, response{Status: s.errorStatus("", sharedCommon.TooManyBulkSubRequests, "requests")})
+		return
+	}
+
+	resp := response{Status: s.okStatus("", 0, 0)}
+	for _, bulkRequest := range bulkRequests {
+		subParams := Params{}
+		for key, value := range params {
+			if key != "requests" {
+				subParams[key] = value
+			}
+		}
+		for key, value := range bulkRequest {
+			subParams[key] = fmt.Sprint(value)
+		}
+
+		subMethod := subParams["requestName"]
+		records, status := s.call(subMethod, subParams)
+		resp.Requests = append(resp.Requests, bulkItemResponse{
+			Status: sharedCommon.StatusBulk{
+				RequestName: subMethod,
+				RequestID:   subParams["requestID"],
+				Status:      status,
+			},
+			Records: records,
+		})
+	}
+
+	s.writeResponse(w, resp)
+}
+
+func (s *Server) checkAuth(method string, params Params) (sharedCommon.ApiError, string) {
+	if s.ClientCode != "" && params["clientCode"] != s.ClientCode {
+		return sharedCommon.AccountNotFound, "clientCode"
+	}
+	if method == "verifyUser" {
+		return 0, ""
+	}
+
+	sessionKey := params["sessionKey"]
+	if sessionKey == "" {
+		return sharedCommon.AuthMissing, "sessionKey"
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	validTill, ok := s.sessions[sessionKey]
+	if !ok || validTill.Before(s.now()) {
+		return sharedCommon.APISessionExpired, "sessionKey"
+	}
+
+	return 0, ""
+}
+
+func (s *Server) call(method string, params Params) (interface{}, sharedCommon.Status) {
+	s.lock.Lock()
+	s.calls[method]++
+	handler, ok := s.handlers[method]
+	injected := s.takeFailure(method)
+	s.lock.Unlock()
+
+	if injected != nil {
+		return nil, s.errorStatus(method, injected.code, injected.errorField)
+	}
+	if !ok {
+		return nil, s.errorStatus(method, sharedCommon.UnknownApi, "request")
+	}
+
+	records, recordsTotal, err := handler(params)
+	if err != nil {
+		var erplyErr *sharedCommon.ErplyError
+		if errors.As(err, &erplyErr) {
+			return nil, s.errorStatus(method, erplyErr.Code, erplyErr.ErrorField)
+		}
+		return nil, s.errorStatus(method, sharedCommon.DbError, "")
+	}
+
+	recordsInResponse := 0
+	if records != nil {
+		raw, err := json.Marshal(records)
+		if err == nil {
+			var list []json.RawMessage
+			if json.Unmarshal(raw, &list) == nil {
+				recordsInResponse = len(list)
+			} else {
+				recordsInResponse = 1
+			}
+		}
+	}
+
+	return records, s.okStatus(method, recordsTotal, recordsInResponse)
+}
+
+//takeFailure gives the injected failure for the method, it should be called under the lock
+func (s *Server) takeFailure(method string) *failure {
+	for i, f := range s.failures {
+		if f.method != "" && f.method != method {
+			continue
+		}
+		if f.times > 0 {
+			f.times--
+			if f.times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		return f
+	}
+
+	return nil
+}
+
+func (s *Server) okStatus(method string, recordsTotal, recordsInResponse int) sharedCommon.Status {
+	return sharedCommon.Status{
+		Request:           method,
+		RequestUnixTime:   int(s.now().Unix()),
+		ResponseStatus:    "ok",
+		RecordsTotal:      recordsTotal,
+		RecordsInResponse: recordsInResponse,
+	}
+}
+
+func (s *Server) errorStatus(method string, code sharedCommon.ApiError, errorField string) sharedCommon.Status {
+	return sharedCommon.Status{
+		Request:         method,
+		RequestUnixTime: int(s.now().Unix()),
+		ResponseStatus:  "error",
+		ErrorCode:       code,
+		ErrorField:      errorField,
+	}
+}
+
+func (s *Server) writeResponse(w http.ResponseWriter, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+//NewError creates an error which a Handler can return to respond with the API error code
+func NewError(code sharedCommon.ApiError, errorField string) error {
+	erplyErr := sharedCommon.NewErplyError("error", code.String(), code)
+	erplyErr.ErrorField = errorField
+	return erplyErr
+}
+
+func (s *Server) verifyUser(params Params) (interface{}, int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	password, ok := s.users[params["username"]]
+	if !ok || password != params["password"] {
+		return nil, 0, NewError(sharedCommon.LoginFailed, "password")
+	}
+
+	sessionLen := params.Int("sessionLength", DefaultSessionLen)
+	if sessionLen <= 0 {
+		sessionLen = DefaultSessionLen
+	}
+
+	s.sessionSeq++
+	sessionKey := fmt.Sprintf("erplytest-session-%d", s.sessionSeq)
+	s.sessions[sessionKey] = s.now().Add(time.Duration(sessionLen) * time.Second)
+
+	return []map[string]interface{}{
+		{
+			"userID":        "1",
+			"userName":      params["username"],
+			"sessionKey":    sessionKey,
+			"sessionLength": sessionLen,
+		},
+	}, 1, nil
+}
+
+func (s *Server) getSessionKeyInfo(params Params) (interface{}, int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	validTill := s.sessions[params["sessionKey"]]
+
+	return []map[string]interface{}{
+		{
+			"creationUnixTime": strconv.FormatInt(validTill.Add(-DefaultSessionLen*time.Second).Unix(), 10),
+			"expireUnixTime":   strconv.FormatInt(validTill.Unix(), 10),
+		},
+	}, 1, nil
+}
+
+func (s *Server) getSessionKeyUser(params Params) (interface{}, int, error) {
+	return []map[string]interface{}{
+		{
+			"userID":     "1",
+			"userName":   DefaultUserName,
+			"sessionKey": params["sessionKey"],
+		},
+	}, 1, nil
+}
+
+//isReservedParam tells if the parameter is a part of the protocol and not a filter
+func isReservedParam(key string) bool {
+	switch strings.ToLower(key) {
+	case "request", "requests", "requestname", "requestid", "clientcode", "sessionkey", "partnerkey",
+		"recordsonpage", "pageno", "lang", "version", "responsemode", "sessionlength", "orderby", "orderbydir":
+		return true
+	}
+
+	return false
+}
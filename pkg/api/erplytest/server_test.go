@@ -0,0 +1,228 @@
+package erplytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/stretchr/testify/assert"
+)
+
+func seedProducts(t *testing.T, srv *Server, count int) {
+	for i := 1; i <= count; i++ {
+		err := srv.Products.Add(products.Product{ProductID: i, Code: "code", GroupID: uint(i % 2), LastModified: uint64(i * 10)})
+		assert.NoError(t, err)
+	}
+}
+
+func TestGetWithPaging(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	seedProducts(t, srv, 45)
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	prods, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{"recordsOnPage": "20", "pageNo": "3"})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 5)
+	assert.Equal(t, 41, prods[0].ProductID)
+
+	prods, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productIDs": "3,5,7", "groupID": "1"})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 3)
+
+	prods, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"changedSince": "440"})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 2)
+
+	assert.Equal(t, 3, srv.Calls("getProducts"))
+}
+
+func TestBulkRequests(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	seedProducts(t, srv, 45)
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	resp, err := cli.ProductManager.GetProductsBulk(
+		context.Background(),
+		[]map[string]interface{}{
+			{"recordsOnPage": 30, "pageNo": 1},
+			{"recordsOnPage": 30, "pageNo": 2},
+		},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, resp.BulkItems, 2)
+	assert.Len(t, resp.BulkItems[0].Products, 30)
+	assert.Len(t, resp.BulkItems[1].Products, 15)
+	assert.Equal(t, 45, resp.BulkItems[1].Status.RecordsTotal)
+	assert.Equal(t, 1, srv.HTTPCalls())
+}
+
+func TestSaveAndDelete(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	report, err := cli.CustomerManager.SaveCustomer(context.Background(), map[string]string{"firstName": "John", "groupID": "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.CustomerID)
+
+	_, err = cli.CustomerManager.SaveCustomer(context.Background(), map[string]string{"customerID": "1", "lastName": "Doe"})
+	assert.NoError(t, err)
+
+	custs, err := cli.CustomerManager.GetCustomers(context.Background(), map[string]string{"customerID": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"John", "Doe"}, []string{custs[0].FirstName, custs[0].LastName})
+	assert.EqualValues(t, 3, custs[0].GroupID)
+
+	stored := customers.Customer{}
+	found, err := srv.Customers.Get(1, &stored)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "Doe", stored.LastName)
+
+	err = cli.CustomerManager.DeleteCustomer(context.Background(), map[string]string{"customerID": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, srv.Customers.Len())
+}
+
+func TestInjectedErrors(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	seedProducts(t, srv, 1)
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	srv.FailNext("getProducts", sharedCommon.HourlyRequestQuota, "", 1)
+
+	_, err = cli.ProductManager.GetProducts(context.Background(), nil)
+	assert.True(t, errors.Is(err, sharedCommon.ErrQuota))
+
+	prods, err := cli.ProductManager.GetProducts(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, prods, 1)
+
+	srv.FailAlways("getCustomers", sharedCommon.InvalidValue, "groupID")
+	_, err = cli.CustomerManager.GetCustomers(context.Background(), nil)
+	var erplyErr *sharedCommon.ErplyError
+	assert.True(t, errors.As(err, &erplyErr))
+	assert.Equal(t, "groupID", erplyErr.ErrorField)
+
+	srv.ClearFailures()
+	_, err = cli.CustomerManager.GetCustomers(context.Background(), nil)
+	assert.NoError(t, err)
+
+	err = cli.Call(context.Background(), "getUnknownThings", nil, nil)
+	assert.Error(t, err)
+
+	srv.Handle("getThings", func(params Params) (interface{}, int, error) {
+		return nil, 0, fmt.Errorf("failed to validate: %w", sharedCommon.NewErplyError("error", "wrong value", sharedCommon.InvalidValue))
+	})
+	err = cli.Call(context.Background(), "getThings", nil, nil)
+	assert.True(t, errors.As(err, &erplyErr))
+	assert.Equal(t, sharedCommon.InvalidValue, erplyErr.Code)
+}
+
+func TestSessions(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	sessionKey, err := auth.VerifyUser(DefaultUserName, DefaultPassword, srv.ClientCode, srv.HTTPClient())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, sessionKey)
+
+	_, err = auth.VerifyUser(DefaultUserName, "wrong", srv.ClientCode, srv.HTTPClient())
+	assert.Error(t, err)
+
+	info, err := auth.GetSessionKeyInfo(sessionKey, srv.ClientCode, srv.HTTPClient())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, info.ExpireUnixTime)
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	srv.ExpireSession(srv.SessionKey)
+	_, err = cli.ProductManager.GetProducts(context.Background(), nil)
+	var erplyErr *sharedCommon.ErplyError
+	assert.True(t, errors.As(err, &erplyErr))
+	assert.Equal(t, sharedCommon.APISessionExpired, erplyErr.Code)
+
+	srv.AddSession(srv.SessionKey, time.Minute)
+	_, err = cli.ProductManager.GetProducts(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestListing(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	seedProducts(t, srv, 250)
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	lister := sharedCommon.NewLister(
+		sharedCommon.ListingSettings{
+			MaxRequestsCountPerSecond: 0,
+			StreamBufferLength:        10,
+			MaxItemsPerRequest:        300,
+			MaxFetchersCount:          2,
+		},
+		products.NewListingDataProvider(cli.ProductManager),
+		func(sleepTime time.Duration) {},
+	)
+
+	ids := map[int]bool{}
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+		if item.Err != nil {
+			return
+		}
+		ids[item.Payload.(products.Product).ProductID] = true
+	}
+	assert.Len(t, ids, 250)
+}
+
+func TestKeysetListing(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	for i := 1; i <= 250; i++ {
+		//the products are changed in groups of 40, so the requests have to go deeper into the same key
+		err := srv.Products.Add(products.Product{ProductID: i, LastModified: uint64(1000 + (250-i)/40*10)})
+		assert.NoError(t, err)
+	}
+
+	cli, err := srv.NewClient()
+	assert.NoError(t, err)
+
+	lister := products.NewProductsKeysetLister(cli.ProductManager, sharedCommon.ListingSettings{MaxItemsPerRequest: 30})
+	ids := map[int]bool{}
+	lastModified := uint64(0)
+	for item := range lister.Get(context.Background(), map[string]interface{}{"changedSince": 1010}) {
+		assert.NoError(t, item.Err)
+		if item.Err != nil {
+			return
+		}
+		product := item.Payload.(products.Product)
+		assert.False(t, ids[product.ProductID], "product %d is repeated", product.ProductID)
+		assert.True(t, product.LastModified >= lastModified)
+		ids[product.ProductID] = true
+		lastModified = product.LastModified
+	}
+	//the products 211-250 were changed at 1000
+	assert.Len(t, ids, 210)
+	assert.Equal(t, 210, lister.Summary().DeliveredCount)
+}
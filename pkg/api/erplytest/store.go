@@ -0,0 +1,448 @@
+package erplytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+	"github.com/erply/api-go-wrapper/pkg/api/prices"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+	"github.com/erply/api-go-wrapper/pkg/api/warehouse"
+)
+
+//StoreConfig describes how the API methods of an entity work
+type StoreConfig struct {
+	//GetMethod lists the records, e.g. getProducts
+	GetMethod string
+	//SaveMethod creates or updates a record, e.g. saveProduct
+	SaveMethod string
+	//DeleteMethod deletes a record by its ID, e.g. deleteProduct
+	DeleteMethod string
+	//IDField is the JSON field of the record ID, the IDs can be filtered by this field or by its plural form e.g. productIDs
+	IDField string
+	//SaveResultField is the JSON field of the ID in the save response, IDField is used if it's empty
+	SaveResultField string
+	//DeleteIDParam is the parameter with the ID of the deleted record, IDField is used if it's empty
+	DeleteIDParam string
+	//Model is the zero value of the model, it defines the JSON types of the fields which are set by save requests
+	Model interface{}
+}
+
+var (
+	ProductsStoreConfig = StoreConfig{
+		GetMethod:    "getProducts",
+		SaveMethod:   "saveProduct",
+		DeleteMethod: "deleteProduct",
+		IDField:      "productID",
+		Model:        products.Product{},
+	}
+	CustomersStoreConfig = StoreConfig{
+		GetMethod:    "getCustomers",
+		SaveMethod:   "saveCustomer",
+		DeleteMethod: "deleteCustomer",
+		IDField:      "customerID",
+		Model:        customers.Customer{},
+	}
+	SuppliersStoreConfig = StoreConfig{
+		GetMethod:    "getSuppliers",
+		SaveMethod:   "saveSupplier",
+		DeleteMethod: "deleteSupplier",
+		IDField:      "supplierID",
+		Model:        customers.Supplier{},
+	}
+	AddressesStoreConfig = StoreConfig{
+		GetMethod:    "getAddresses",
+		SaveMethod:   "saveAddress",
+		DeleteMethod: "deleteAddress",
+		IDField:      "addressID",
+		Model:        sharedCommon.Address{},
+	}
+	WarehousesStoreConfig = StoreConfig{
+		GetMethod:  "getWarehouses",
+		SaveMethod: "saveWarehouse",
+		IDField:    "warehouseID",
+		Model:      warehouse.Warehouse{},
+	}
+	SalesDocumentsStoreConfig = StoreConfig{
+		GetMethod:       "getSalesDocuments",
+		SaveMethod:      "saveSalesDocument",
+		DeleteMethod:    "deleteSalesDocument",
+		IDField:         "id",
+		SaveResultField: "invoiceID",
+		DeleteIDParam:   "documentID",
+		Model:           sales.SaleDocument{},
+	}
+	PaymentsStoreConfig = StoreConfig{
+		GetMethod:    "getPayments",
+		SaveMethod:   "savePayment",
+		DeleteMethod: "deletePayment",
+		IDField:      "paymentID",
+		Model:        sales.PaymentInfo{},
+	}
+	PriceListsStoreConfig = StoreConfig{
+		GetMethod:  "getPriceLists",
+		SaveMethod: "savePriceList",
+		IDField:    "pricelistID",
+		Model:      prices.RegularPriceList{},
+	}
+)
+
+//Store keeps the records of an entity as JSON objects and serves the get, save and delete API methods of it
+type Store struct {
+	config   StoreConfig
+	template map[string]interface{}
+	lock     sync.Mutex
+	records  map[int]map[string]interface{}
+	lastID   int
+}
+
+//NewStore creates a store and registers the handlers of its API methods on the server
+func (s *Server) NewStore(config StoreConfig) *Store {
+	st := &Store{
+		config:   config,
+		template: map[string]interface{}{},
+		records:  map[int]map[string]interface{}{},
+	}
+	if config.Model != nil {
+		if template, err := toJSONObject(config.Model); err == nil {
+			st.template = template
+		}
+	}
+
+	if config.GetMethod != "" {
+		s.Handle(config.GetMethod, st.handleGet)
+	}
+	if config.SaveMethod != "" {
+		s.Handle(config.SaveMethod, st.handleSave)
+	}
+	if config.DeleteMethod != "" {
+		s.Handle(config.DeleteMethod, st.handleDelete)
+	}
+
+	return st
+}
+
+//Add seeds the store with records, which can be models of this library or maps, records without an ID get a new one
+func (st *Store) Add(records ...interface{}) error {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	for _, record := range records {
+		obj, err := toJSONObject(record)
+		if err != nil {
+			return err
+		}
+
+		id, ok := st.recordID(obj)
+		if !ok || id == 0 {
+			st.lastID++
+			id = st.lastID
+			st.setField(obj, st.config.IDField, strconv.Itoa(id))
+		}
+		if id > st.lastID {
+			st.lastID = id
+		}
+		st.records[id] = obj
+	}
+
+	return nil
+}
+
+//Get decodes the record with the ID into dest, it returns false if there is no such record
+func (st *Store) Get(id int, dest interface{}) (bool, error) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	record, ok := st.records[id]
+	if !ok {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return true, err
+	}
+
+	return true, json.Unmarshal(raw, dest)
+}
+
+//Len gives the amount of records in the store
+func (st *Store) Len() int {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	return len(st.records)
+}
+
+//Reset removes all records
+func (st *Store) Reset() {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	st.records = map[int]map[string]interface{}{}
+	st.lastID = 0
+}
+
+//Records gives the records matching the filters ordered by ID and the total amount of matching records,
+//orderBy=changed orders them by lastModified with orderByDir asc or desc. The page is selected by the recordsOnPage and pageNo parameters
+func (st *Store) Records(params Params) ([]map[string]interface{}, int) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	ids := make([]int, 0, len(st.records))
+	for id, record := range st.records {
+		if st.matches(record, params) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if strings.EqualFold(params["orderBy"], "changed") {
+		lastModified := func(i int) int64 {
+			value, _ := strconv.ParseInt(fieldString(st.records[ids[i]], "lastModified"), 10, 64)
+			return value
+		}
+		desc := strings.EqualFold(params["orderByDir"], "desc")
+		sort.SliceStable(ids, func(i, j int) bool {
+			if desc {
+				return lastModified(i) > lastModified(j)
+			}
+			return lastModified(i) < lastModified(j)
+		})
+	}
+
+	recordsOnPage := params.Int("recordsOnPage", DefaultRecordsOnPage)
+	if recordsOnPage <= 0 {
+		recordsOnPage = DefaultRecordsOnPage
+	}
+	if recordsOnPage > MaxRecordsOnPage {
+		recordsOnPage = MaxRecordsOnPage
+	}
+	pageNo := params.Int("pageNo", 1)
+	if pageNo < 1 {
+		pageNo = 1
+	}
+
+	page := make([]map[string]interface{}, 0, recordsOnPage)
+	start := (pageNo - 1) * recordsOnPage
+	for i := start; i < len(ids) && i < start+recordsOnPage; i++ {
+		page = append(page, st.records[ids[i]])
+	}
+
+	return page, len(ids)
+}
+
+func (st *Store) handleGet(params Params) (interface{}, int, error) {
+	records, total := st.Records(params)
+	return records, total, nil
+}
+
+func (st *Store) handleSave(params Params) (interface{}, int, error) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	id := 0
+	if rawID, ok := params[st.config.IDField]; ok && rawID != "" && rawID != "0" {
+		var err error
+		id, err = strconv.Atoi(rawID)
+		if err != nil {
+			return nil, 0, NewError(sharedCommon.InvalidFormat, st.config.IDField)
+		}
+		if _, exists := st.records[id]; !exists {
+			return nil, 0, NewError(sharedCommon.NoRecordsFound, st.config.IDField)
+		}
+	}
+
+	record, exists := st.records[id]
+	if !exists {
+		record = copyJSONObject(st.template)
+		st.lastID++
+		id = st.lastID
+		st.setField(record, st.config.IDField, strconv.Itoa(id))
+	}
+
+	for key, value := range params {
+		if isReservedParam(key) || strings.EqualFold(key, st.config.IDField) {
+			continue
+		}
+		st.setField(record, key, value)
+	}
+	st.records[id] = record
+
+	resultField := st.config.SaveResultField
+	if resultField == "" {
+		resultField = st.config.IDField
+	}
+
+	return []map[string]interface{}{{resultField: id}}, 1, nil
+}
+
+func (st *Store) handleDelete(params Params) (interface{}, int, error) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+
+	idParam := st.config.DeleteIDParam
+	if idParam == "" {
+		idParam = st.config.IDField
+	}
+
+	id, err := strconv.Atoi(params[idParam])
+	if err != nil {
+		return nil, 0, NewError(sharedCommon.RequiredParamMissing, idParam)
+	}
+	if _, exists := st.records[id]; !exists {
+		return nil, 0, NewError(sharedCommon.NoRecordsFound, idParam)
+	}
+	delete(st.records, id)
+
+	return nil, 0, nil
+}
+
+//matches checks the filters which are fields of the record, the ID list in the plural ID field
+//and changedSince against the lastModified field, other filters are ignored
+func (st *Store) matches(record map[string]interface{}, params Params) bool {
+	for key, value := range params {
+		if isReservedParam(key) || value == "" {
+			continue
+		}
+
+		if strings.EqualFold(key, st.config.IDField+"s") {
+			id, _ := st.recordID(record)
+			if !containsID(value, id) {
+				return false
+			}
+			continue
+		}
+
+		if strings.EqualFold(key, "changedSince") {
+			changedSince, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			lastModified, _ := strconv.ParseInt(fieldString(record, "lastModified"), 10, 64)
+			if lastModified < changedSince {
+				return false
+			}
+			continue
+		}
+
+		fieldKey, ok := findField(record, key)
+		if !ok {
+			continue
+		}
+		if fieldString(record, fieldKey) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (st *Store) recordID(record map[string]interface{}) (int, bool) {
+	key, ok := findField(record, st.config.IDField)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(fieldString(record, key))
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+//setField sets the value with the JSON type of the existing field or of the model field
+func (st *Store) setField(record map[string]interface{}, key, value string) {
+	fieldKey, ok := findField(record, key)
+	if !ok {
+		fieldKey, ok = findField(st.template, key)
+		if !ok {
+			fieldKey = key
+		}
+	}
+
+	current, ok := record[fieldKey]
+	if !ok {
+		current = st.template[fieldKey]
+	}
+
+	switch current.(type) {
+	case float64:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			record[fieldKey] = number
+			return
+		}
+	case bool:
+		record[fieldKey] = value == "1" || strings.EqualFold(value, "true")
+		return
+	}
+
+	record[fieldKey] = value
+}
+
+func findField(record map[string]interface{}, key string) (string, bool) {
+	if _, ok := record[key]; ok {
+		return key, true
+	}
+	for fieldKey := range record {
+		if strings.EqualFold(fieldKey, key) {
+			return fieldKey, true
+		}
+	}
+
+	return "", false
+}
+
+func fieldString(record map[string]interface{}, key string) string {
+	fieldKey, ok := findField(record, key)
+	if !ok {
+		return ""
+	}
+
+	switch v := record[fieldKey].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func containsID(list string, id int) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == strconv.Itoa(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toJSONObject(record interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, fmt.Errorf("record should be a JSON object: %v", err)
+	}
+
+	return obj, nil
+}
+
+func copyJSONObject(obj map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		res[key] = value
+	}
+
+	return res
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//DefaultJWTRenewalMargin is how long before the expiry a cached token is replaced
+const DefaultJWTRenewalMargin = time.Minute
+
+//JWTSessionProvider gets a token from the API, caches it and replaces it before it expires according to its exp claim.
+//It can be used as the SessionProvider of a client for services which accept JWTs or directly with GetToken.
+//The client given to the constructors should not use the provider itself, otherwise fetching a token would need a token
+type JWTSessionProvider struct {
+	//Fetch gets a new token from the API
+	Fetch func(ctx context.Context) (string, error)
+	//ClientCode is the account the tokens should belong to, the account claim is not checked if it's empty
+	ClientCode string
+	//Margin is how long before the expiry the token is renewed, DefaultJWTRenewalMargin if not set
+	Margin  time.Duration
+	Metrics sharedCommon.Metrics
+	Lock    sync.Mutex
+
+	token  string
+	claims *auth.JWTClaims
+}
+
+//NewJWTSessionProvider creates a provider of getJwtToken tokens, both api.Client.AuthProvider and
+//api.PartnerClient.PartnerTokenProvider can be given to it
+func NewJWTSessionProvider(tokenProvider auth.PartnerTokenProvider, clientCode string) *JWTSessionProvider {
+	return &JWTSessionProvider{
+		Fetch: func(ctx context.Context) (string, error) {
+			token, err := tokenProvider.GetJWTToken(ctx)
+			if err != nil {
+				return "", err
+			}
+			return token.Token, nil
+		},
+		ClientCode: clientCode,
+	}
+}
+
+//NewIdentityTokenSessionProvider creates a provider of getIdentityToken tokens
+func NewIdentityTokenSessionProvider(authProvider auth.Provider, clientCode string) *JWTSessionProvider {
+	return &JWTSessionProvider{
+		Fetch: func(ctx context.Context) (string, error) {
+			token, err := authProvider.GetIdentityToken(ctx)
+			if err != nil {
+				return "", err
+			}
+			return token.Jwt, nil
+		},
+		ClientCode: clientCode,
+	}
+}
+
+//GetSession gives the cached token or fetches a new one, it allows to use the provider as a SessionProvider
+func (jsp *JWTSessionProvider) GetSession() (sessionKey string, err error) {
+	return jsp.GetToken(context.Background())
+}
+
+//GetToken gives the cached token if it's not close to its expiry, otherwise a new one is fetched
+func (jsp *JWTSessionProvider) GetToken(ctx context.Context) (string, error) {
+	jsp.Lock.Lock()
+	defer jsp.Lock.Unlock()
+
+	if jsp.isTokenValid() {
+		return jsp.token, nil
+	}
+
+	log.Log.Log(log.Debug, "will fetch a new JWT since the cached one is missing or expires soon")
+	token, err := jsp.Fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := auth.DecodeJWTClaims(token)
+	if err != nil {
+		return "", err
+	}
+	if jsp.ClientCode != "" && claims.ClientCode != "" && claims.ClientCode != jsp.ClientCode {
+		return "", sharedCommon.NewErplyError(
+			"Error",
+			"the fetched JWT belongs to the account "+claims.ClientCode+" instead of "+jsp.ClientCode,
+			sharedCommon.WrongJWTAccount,
+		)
+	}
+	if claims.IsExpired(time.Now()) {
+		return "", sharedCommon.NewErplyError("Error", "the fetched JWT is already expired", sharedCommon.JWTExpired)
+	}
+
+	log.Log.Log(log.Debug, "got a new JWT which expires at %v", claims.ExpiresAt)
+	if jsp.Metrics != nil {
+		jsp.Metrics.SessionRefreshed()
+	}
+
+	jsp.token = token
+	jsp.claims = claims
+
+	return jsp.token, nil
+}
+
+//Claims gives the claims of the cached token or nil if there is no token
+func (jsp *JWTSessionProvider) Claims() *auth.JWTClaims {
+	jsp.Lock.Lock()
+	defer jsp.Lock.Unlock()
+
+	return jsp.claims
+}
+
+func (jsp *JWTSessionProvider) Invalidate() {
+	jsp.Lock.Lock()
+	defer jsp.Lock.Unlock()
+
+	jsp.token = ""
+	jsp.claims = nil
+}
+
+//HandleError drops the cached token if the API rejected it with JWTExpired, JWTDecodingFailure or WrongJWTAccount,
+//it returns true if the request should be repeated with a new token
+func (jsp *JWTSessionProvider) HandleError(err error) bool {
+	if !auth.IsJWTRefreshError(err) {
+		return false
+	}
+
+	log.Log.Log(log.Debug, "the JWT was rejected, will fetch a new one: %v", err)
+	jsp.Invalidate()
+
+	return true
+}
+
+func (jsp *JWTSessionProvider) isTokenValid() bool {
+	if jsp.token == "" || jsp.claims == nil {
+		return false
+	}
+
+	margin := jsp.Margin
+	if margin <= 0 {
+		margin = DefaultJWTRenewalMargin
+	}
+	//short living tokens are used at least for the half of their lifetime
+	if !jsp.claims.IssuedAt.IsZero() && !jsp.claims.ExpiresAt.IsZero() {
+		if lifetime := jsp.claims.ExpiresAt.Sub(jsp.claims.IssuedAt); margin > lifetime/2 {
+			margin = lifetime / 2
+		}
+	}
+
+	return !jsp.claims.IsExpired(time.Now().Add(margin))
+}
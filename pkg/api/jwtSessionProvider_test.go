@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/erply/api-go-wrapper/internal/common"
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type jwtServer struct {
+	*httptest.Server
+	lock       sync.Mutex
+	tokenCalls int
+	//tokenLen is the lifetime of the issued tokens
+	tokenLen   time.Duration
+	clientCode string
+}
+
+func newJWTServer(tokenLen time.Duration) *jwtServer {
+	js := &jwtServer{tokenLen: tokenLen, clientCode: "123"}
+	js.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		js.lock.Lock()
+		defer js.lock.Unlock()
+
+		switch r.FormValue("request") {
+		case "getJwtToken":
+			js.tokenCalls++
+			fmt.Fprintf(w, `{"status":{"responseStatus":"ok"},"records":{"token":%q}}`, js.token())
+		case "getIdentityToken":
+			js.tokenCalls++
+			fmt.Fprintf(w, `{"status":{"responseStatus":"ok"},"records":{"identityToken":%q}}`, js.token())
+		default:
+			fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"records":[]}`)
+		}
+	}))
+
+	return js
+}
+
+func (js *jwtServer) token() string {
+	now := time.Now()
+	payload := fmt.Sprintf(`{"iat":%d,"exp":%d,"clientCode":%q,"n":%d}`, now.Unix(), now.Add(js.tokenLen).Unix(), js.clientCode, js.tokenCalls)
+	encode := base64.RawURLEncoding.EncodeToString
+
+	return encode([]byte(`{"alg":"RS256"}`)) + "." + encode([]byte(payload)) + ".signature"
+}
+
+func (js *jwtServer) commonClient() *common.Client {
+	cli := common.NewClient("somesess", "123", "somepartner", nil, nil)
+	cli.Url = js.URL
+	return cli
+}
+
+func TestJWTSessionProvider(t *testing.T) {
+	srv := newJWTServer(time.Hour)
+	defer srv.Close()
+
+	for _, tokenProvider := range []auth.PartnerTokenProvider{auth.NewClient(srv.commonClient()), auth.NewPartnerClient(srv.commonClient())} {
+		srv.tokenCalls = 0
+		provider := NewJWTSessionProvider(tokenProvider, "123")
+
+		token, err := provider.GetToken(context.Background())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), provider.Claims().ExpiresAt, 2*time.Second)
+
+		cachedToken, err := provider.GetSession()
+		assert.NoError(t, err)
+		assert.Equal(t, token, cachedToken)
+		assert.Equal(t, 1, srv.tokenCalls)
+
+		assert.False(t, provider.HandleError(errors.New("network failure")))
+		assert.True(t, provider.HandleError(sharedCommon.NewErplyError("Error", "expired", sharedCommon.JWTExpired)))
+		newToken, err := provider.GetToken(context.Background())
+		assert.NoError(t, err)
+		assert.NotEqual(t, token, newToken)
+		assert.Equal(t, 2, srv.tokenCalls)
+	}
+
+	provider := NewIdentityTokenSessionProvider(auth.NewClient(srv.commonClient()), "123")
+	token, err := provider.GetToken(context.Background())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+}
+
+func TestJWTSessionProviderRenewsBeforeExpiry(t *testing.T) {
+	srv := newJWTServer(2 * time.Second)
+	defer srv.Close()
+
+	provider := NewJWTSessionProvider(auth.NewClient(srv.commonClient()), "123")
+	provider.Margin = time.Hour
+
+	token, err := provider.GetToken(context.Background())
+	assert.NoError(t, err)
+
+	//the margin is longer than the token, so the token is used for the half of its lifetime
+	cachedToken, err := provider.GetToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, token, cachedToken)
+
+	time.Sleep(1100 * time.Millisecond)
+	newToken, err := provider.GetToken(context.Background())
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, newToken)
+	assert.Equal(t, 2, srv.tokenCalls)
+}
+
+func TestJWTSessionProviderWrongAccount(t *testing.T) {
+	srv := newJWTServer(time.Hour)
+	defer srv.Close()
+	srv.clientCode = "456"
+
+	provider := NewJWTSessionProvider(auth.NewClient(srv.commonClient()), "123")
+	_, err := provider.GetToken(context.Background())
+
+	var erplyErr *sharedCommon.ErplyError
+	assert.True(t, errors.As(err, &erplyErr))
+	assert.Equal(t, sharedCommon.WrongJWTAccount, erplyErr.Code)
+	assert.Nil(t, provider.Claims())
+}
+
+func TestClientWithJWTSessionProvider(t *testing.T) {
+	tokenSrv := newJWTServer(time.Hour)
+	defer tokenSrv.Close()
+
+	var usedTokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usedTokens = append(usedTokens, r.FormValue("sessionKey"))
+		if len(usedTokens) == 1 {
+			fmt.Fprint(w, `{"status":{"responseStatus":"error","errorCode":1194},"records":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"records":[]}`)
+	}))
+	defer srv.Close()
+
+	cli := ClientBuilder{
+		ClientCode:      "123",
+		URL:             srv.URL,
+		SessionProvider: NewJWTSessionProvider(auth.NewClient(tokenSrv.commonClient()), "123"),
+	}.Build()
+
+	//the expired token is replaced and the request is repeated
+	err := cli.Call(context.Background(), "getServerTime", nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, usedTokens, 2)
+	assert.NotEqual(t, usedTokens[0], usedTokens[1])
+	assert.Equal(t, 2, tokenSrv.tokenCalls)
+}
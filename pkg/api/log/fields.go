@@ -0,0 +1,168 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//RedactedValue replaces the credentials in the log records
+const RedactedValue = "REDACTED"
+
+//RedactedKeys are the parameter names which values are never logged, the comparison is case insensitive
+var RedactedKeys = []string{"sessionKey", "password", "partnerKey"}
+
+//Field is a key/value pair of a structured log record
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+//F creates a field
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+//IsRedactedKey tells if the values of the parameter should not be logged
+func IsRedactedKey(key string) bool {
+	for _, redactedKey := range RedactedKeys {
+		if strings.EqualFold(key, redactedKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//RedactFields gives a copy of the fields where the credentials are replaced, fields with a redacted key are replaced fully,
+//otherwise the values of parameter maps, url.Values, bulk payloads and JSON strings with the redacted keys are replaced
+func RedactFields(fields []Field) []Field {
+	res := make([]Field, len(fields))
+	for i, field := range fields {
+		if IsRedactedKey(field.Key) {
+			res[i] = Field{Key: field.Key, Value: RedactedValue}
+			continue
+		}
+		res[i] = Field{Key: field.Key, Value: RedactValue(field.Value)}
+	}
+
+	return res
+}
+
+//RedactValue gives a copy of the parameters value with replaced credentials, values of other types are returned as they are
+func RedactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]string:
+		res := make(map[string]string, len(v))
+		for key, val := range v {
+			if IsRedactedKey(key) {
+				val = RedactedValue
+			} else if key == "requests" {
+				val = redactJSON(val)
+			}
+			res[key] = val
+		}
+		return res
+	case url.Values:
+		res := make(url.Values, len(v))
+		for key, vals := range v {
+			if IsRedactedKey(key) {
+				vals = []string{RedactedValue}
+			} else if key == "requests" {
+				redactedVals := make([]string, len(vals))
+				for i, val := range vals {
+					redactedVals[i] = redactJSON(val)
+				}
+				vals = redactedVals
+			}
+			res[key] = vals
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			if IsRedactedKey(key) {
+				res[key] = RedactedValue
+				continue
+			}
+			res[key] = RedactValue(val)
+		}
+		return res
+	case []map[string]interface{}:
+		res := make([]map[string]interface{}, len(v))
+		for i, item := range v {
+			res[i] = RedactValue(item).(map[string]interface{})
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			res[i] = RedactValue(item)
+		}
+		return res
+	default:
+		return value
+	}
+}
+
+//redactJSON replaces the credentials in a JSON payload like the requests parameter of bulk calls
+func redactJSON(payload string) string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(payload), &decoded); err != nil {
+		return payload
+	}
+
+	redacted, err := json.Marshal(RedactValue(decoded))
+	if err != nil {
+		return payload
+	}
+
+	return string(redacted)
+}
+
+//FormatFields appends the fields to the message as key=value pairs ordered like the fields
+func FormatFields(message string, fields []Field) string {
+	if len(fields) == 0 {
+		return message
+	}
+
+	parts := make([]string, 0, len(fields)+1)
+	parts = append(parts, message)
+	for _, field := range fields {
+		parts = append(parts, field.Key+"="+formatValue(field.Value))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func formatValue(value interface{}) string {
+	var formatted string
+	switch v := value.(type) {
+	case string:
+		formatted = v
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, len(keys))
+		for i, key := range keys {
+			pairs[i] = key + ":" + v[key]
+		}
+		formatted = "map[" + strings.Join(pairs, " ") + "]"
+	case url.Values:
+		formatted = v.Encode()
+	default:
+		formatted = fmt.Sprintf("%+v", value)
+	}
+
+	if formatted == "" || strings.ContainsAny(formatted, " \t\n\"=") {
+		return strconv.Quote(formatted)
+	}
+
+	return formatted
+}
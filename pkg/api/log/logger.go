@@ -1,6 +1,9 @@
 package log
 
-import "log"
+import (
+	"fmt"
+	"log"
+)
 
 var Log Logger
 
@@ -17,16 +20,152 @@ const (
 	Error
 )
 
+func (t Type) String() string {
+	switch t {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	default:
+		return fmt.Sprintf("level(%d)", int(t))
+	}
+}
+
 type Logger interface {
 	Log(t Type, message string, arguments ...interface{})
 }
 
+//StructuredLogger is implemented by loggers which accept key/value fields, the library uses them
+//for its records instead of printf formatting, the credentials in the fields are already redacted
+type StructuredLogger interface {
+	LogFields(t Type, message string, fields ...Field)
+}
+
+//Record writes a structured record to Log, if Log is not a StructuredLogger the fields are appended to the message
+func Record(t Type, message string, fields ...Field) {
+	fields = RedactFields(fields)
+	if structuredLogger, ok := Log.(StructuredLogger); ok {
+		structuredLogger.LogFields(t, message, fields...)
+		return
+	}
+
+	Log.Log(t, "%s", FormatFields(message, fields))
+}
+
 type NullLogger struct{}
 
 func (nl NullLogger) Log(t Type, message string, arguments ...interface{}) {}
 
-type StdLogger struct{}
+func (nl NullLogger) LogFields(t Type, message string, fields ...Field) {}
+
+//StdLogger writes the records with the level to the standard library logger
+type StdLogger struct {
+	//MinLevel filters out the records with a lower level, all records are written if it's not set
+	MinLevel Type
+	//Out is the destination logger, the standard logger of the log package is used if it's nil
+	Out *log.Logger
+}
 
 func (sl StdLogger) Log(t Type, message string, arguments ...interface{}) {
-	log.Printf(message, arguments...)
+	if t < sl.MinLevel {
+		return
+	}
+
+	sl.print(t, fmt.Sprintf(message, arguments...))
+}
+
+func (sl StdLogger) LogFields(t Type, message string, fields ...Field) {
+	if t < sl.MinLevel {
+		return
+	}
+
+	sl.print(t, FormatFields(message, fields))
+}
+
+func (sl StdLogger) print(t Type, line string) {
+	if sl.Out != nil {
+		sl.Out.Printf("[%s] %s", t, line)
+		return
+	}
+
+	log.Printf("[%s] %s", t, line)
+}
+
+//LevelFilter passes only the records with MinLevel or a higher level to Logger
+type LevelFilter struct {
+	Logger   Logger
+	MinLevel Type
+}
+
+func (lf LevelFilter) Log(t Type, message string, arguments ...interface{}) {
+	if t < lf.MinLevel {
+		return
+	}
+
+	lf.Logger.Log(t, message, arguments...)
+}
+
+func (lf LevelFilter) LogFields(t Type, message string, fields ...Field) {
+	if t < lf.MinLevel {
+		return
+	}
+
+	if structuredLogger, ok := lf.Logger.(StructuredLogger); ok {
+		structuredLogger.LogFields(t, message, fields...)
+		return
+	}
+
+	lf.Logger.Log(t, "%s", FormatFields(message, fields))
+}
+
+//SlogLogger is the part of *slog.Logger which is used by SlogAdapter, so a *slog.Logger or any logger
+//with the same methods can be given to it
+type SlogLogger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+//SlogAdapter writes the records to a log/slog style logger, the fields are passed as key/value arguments
+type SlogAdapter struct {
+	Logger   SlogLogger
+	MinLevel Type
+}
+
+func (sa SlogAdapter) Log(t Type, message string, arguments ...interface{}) {
+	if t < sa.MinLevel {
+		return
+	}
+
+	sa.write(t, fmt.Sprintf(message, arguments...), nil)
+}
+
+func (sa SlogAdapter) LogFields(t Type, message string, fields ...Field) {
+	if t < sa.MinLevel {
+		return
+	}
+
+	args := make([]interface{}, 0, len(fields)*2)
+	for _, field := range fields {
+		args = append(args, field.Key, field.Value)
+	}
+	sa.write(t, message, args)
+}
+
+func (sa SlogAdapter) write(t Type, message string, args []interface{}) {
+	switch {
+	case t <= Debug:
+		sa.Logger.Debug(message, args...)
+	case t == Info:
+		sa.Logger.Info(message, args...)
+	case t == Warn:
+		sa.Logger.Warn(message, args...)
+	default:
+		sa.Logger.Error(message, args...)
+	}
 }
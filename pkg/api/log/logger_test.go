@@ -0,0 +1,101 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type printfLogger struct {
+	lines []string
+}
+
+func (pl *printfLogger) Log(t Type, message string, arguments ...interface{}) {
+	pl.lines = append(pl.lines, fmt.Sprintf(message, arguments...))
+}
+
+type slogMock struct {
+	calls []string
+	args  [][]interface{}
+}
+
+func (sm *slogMock) Debug(msg string, args ...interface{}) { sm.record("debug", msg, args) }
+func (sm *slogMock) Info(msg string, args ...interface{})  { sm.record("info", msg, args) }
+func (sm *slogMock) Warn(msg string, args ...interface{})  { sm.record("warn", msg, args) }
+func (sm *slogMock) Error(msg string, args ...interface{}) { sm.record("error", msg, args) }
+
+func (sm *slogMock) record(level, msg string, args []interface{}) {
+	sm.calls = append(sm.calls, level+" "+msg)
+	sm.args = append(sm.args, args)
+}
+
+func withLogger(logger Logger, f func()) {
+	oldLogger := Log
+	Log = logger
+	defer func() {
+		Log = oldLogger
+	}()
+	f()
+}
+
+func TestRedactFields(t *testing.T) {
+	fields := RedactFields([]Field{
+		F("sessionKey", "secret"),
+		F("filters", map[string]string{"Password": "secret", "username": "john"}),
+		F("headers", url.Values{"partnerKey": {"secret"}, "clientCode": {"123"}}),
+		F("requests", []map[string]interface{}{{"requestName": "verifyUser", "password": "secret"}}),
+		F("body", map[string]string{"requests": `[{"requestName":"getProducts","sessionKey":"secret"}]`}),
+		F("count", 3),
+	})
+
+	assert.Equal(t, []Field{
+		F("sessionKey", RedactedValue),
+		F("filters", map[string]string{"Password": RedactedValue, "username": "john"}),
+		F("headers", url.Values{"partnerKey": {RedactedValue}, "clientCode": {"123"}}),
+		F("requests", []map[string]interface{}{{"requestName": "verifyUser", "password": RedactedValue}}),
+		F("body", map[string]string{"requests": `[{"requestName":"getProducts","sessionKey":"REDACTED"}]`}),
+		F("count", 3),
+	}, fields)
+}
+
+func TestRecordWithPrintfLogger(t *testing.T) {
+	logger := &printfLogger{}
+	withLogger(logger, func() {
+		Record(Debug, "will call API method", F("method", "verifyUser"), F("filters", map[string]string{"password": "secret", "note": "a b"}))
+		Record(Info, "100% done")
+	})
+
+	assert.Equal(t, []string{
+		`will call API method method=verifyUser filters="map[note:a b password:REDACTED]"`,
+		"100% done",
+	}, logger.lines)
+}
+
+func TestStdLoggerLevels(t *testing.T) {
+	buf := &bytes.Buffer{}
+	withLogger(StdLogger{MinLevel: Info, Out: log.New(buf, "", 0)}, func() {
+		Log.Log(Debug, "hidden %d", 1)
+		Log.Log(Warn, "shown %d", 2)
+		Record(Debug, "hidden record")
+		Record(Error, "failed", F("partnerKey", "secret"), F("code", 1016))
+	})
+
+	assert.Equal(t, "[warn] shown 2\n[error] failed partnerKey=REDACTED code=1016\n", buf.String())
+}
+
+func TestLevelFilterAndSlogAdapter(t *testing.T) {
+	slog := &slogMock{}
+	withLogger(LevelFilter{Logger: SlogAdapter{Logger: slog}, MinLevel: Info}, func() {
+		Record(Debug, "hidden")
+		Record(Info, "got response", F("statusCode", 200), F("sessionKey", "secret"))
+		Log.Log(Error, "failed: %v", "timeout")
+	})
+
+	assert.Equal(t, []string{"info got response", "error failed: timeout"}, slog.calls)
+	assert.Equal(t, []interface{}{"statusCode", 200, "sessionKey", RedactedValue}, slog.args[0])
+	assert.Len(t, slog.args[1], 0)
+}
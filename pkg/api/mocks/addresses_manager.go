@@ -0,0 +1,128 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/addresses"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+var _ addresses.Manager = (*AddressesManagerMock)(nil)
+
+//AddressesManagerMock mocks addresses.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type AddressesManagerMock struct {
+	CallRecorder
+	GetAddressesFunc      func(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error)
+	GetAddressesBulkFunc  func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (addresses.GetAddressesResponseBulk, error)
+	GetAddressTypesFunc   func(ctx context.Context, filters map[string]string) ([]addresses.Type, error)
+	SaveAddressFunc       func(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error)
+	SaveAddressesBulkFunc func(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (addresses.SaveAddressesResponseBulk, error)
+	DeleteAddressFunc     func(ctx context.Context, filters map[string]string) error
+	DeleteAddressBulkFunc func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (addresses.DeleteAddressResponseBulk, error)
+}
+
+//NewAddressesManagerMock creates a mock where all methods return ErrNotImplemented
+func NewAddressesManagerMock() *AddressesManagerMock {
+	return &AddressesManagerMock{
+		GetAddressesFunc: func(ctx context.Context, filters map[string]string) (r0 []sharedCommon.Address, r1 error) {
+			r1 = notImplemented("addresses.Manager", "GetAddresses")
+			return
+		},
+		GetAddressesBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 addresses.GetAddressesResponseBulk, r1 error) {
+			r1 = notImplemented("addresses.Manager", "GetAddressesBulk")
+			return
+		},
+		GetAddressTypesFunc: func(ctx context.Context, filters map[string]string) (r0 []addresses.Type, r1 error) {
+			r1 = notImplemented("addresses.Manager", "GetAddressTypes")
+			return
+		},
+		SaveAddressFunc: func(ctx context.Context, filters map[string]string) (r0 []sharedCommon.Address, r1 error) {
+			r1 = notImplemented("addresses.Manager", "SaveAddress")
+			return
+		},
+		SaveAddressesBulkFunc: func(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (r0 addresses.SaveAddressesResponseBulk, r1 error) {
+			r1 = notImplemented("addresses.Manager", "SaveAddressesBulk")
+			return
+		},
+		DeleteAddressFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("addresses.Manager", "DeleteAddress")
+			return
+		},
+		DeleteAddressBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 addresses.DeleteAddressResponseBulk, r1 error) {
+			r1 = notImplemented("addresses.Manager", "DeleteAddressBulk")
+			return
+		},
+	}
+}
+
+//GetAddresses calls GetAddressesFunc
+func (m *AddressesManagerMock) GetAddresses(ctx context.Context, filters map[string]string) (r0 []sharedCommon.Address, r1 error) {
+	m.record("GetAddresses", ctx, filters)
+	if m.GetAddressesFunc == nil {
+		r1 = notImplemented("addresses.Manager", "GetAddresses")
+		return
+	}
+	return m.GetAddressesFunc(ctx, filters)
+}
+
+//GetAddressesBulk calls GetAddressesBulkFunc
+func (m *AddressesManagerMock) GetAddressesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 addresses.GetAddressesResponseBulk, r1 error) {
+	m.record("GetAddressesBulk", ctx, bulkFilters, baseFilters)
+	if m.GetAddressesBulkFunc == nil {
+		r1 = notImplemented("addresses.Manager", "GetAddressesBulk")
+		return
+	}
+	return m.GetAddressesBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetAddressTypes calls GetAddressTypesFunc
+func (m *AddressesManagerMock) GetAddressTypes(ctx context.Context, filters map[string]string) (r0 []addresses.Type, r1 error) {
+	m.record("GetAddressTypes", ctx, filters)
+	if m.GetAddressTypesFunc == nil {
+		r1 = notImplemented("addresses.Manager", "GetAddressTypes")
+		return
+	}
+	return m.GetAddressTypesFunc(ctx, filters)
+}
+
+//SaveAddress calls SaveAddressFunc
+func (m *AddressesManagerMock) SaveAddress(ctx context.Context, filters map[string]string) (r0 []sharedCommon.Address, r1 error) {
+	m.record("SaveAddress", ctx, filters)
+	if m.SaveAddressFunc == nil {
+		r1 = notImplemented("addresses.Manager", "SaveAddress")
+		return
+	}
+	return m.SaveAddressFunc(ctx, filters)
+}
+
+//SaveAddressesBulk calls SaveAddressesBulkFunc
+func (m *AddressesManagerMock) SaveAddressesBulk(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (r0 addresses.SaveAddressesResponseBulk, r1 error) {
+	m.record("SaveAddressesBulk", ctx, addrMap, attrs)
+	if m.SaveAddressesBulkFunc == nil {
+		r1 = notImplemented("addresses.Manager", "SaveAddressesBulk")
+		return
+	}
+	return m.SaveAddressesBulkFunc(ctx, addrMap, attrs)
+}
+
+//DeleteAddress calls DeleteAddressFunc
+func (m *AddressesManagerMock) DeleteAddress(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteAddress", ctx, filters)
+	if m.DeleteAddressFunc == nil {
+		r0 = notImplemented("addresses.Manager", "DeleteAddress")
+		return
+	}
+	return m.DeleteAddressFunc(ctx, filters)
+}
+
+//DeleteAddressBulk calls DeleteAddressBulkFunc
+func (m *AddressesManagerMock) DeleteAddressBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 addresses.DeleteAddressResponseBulk, r1 error) {
+	m.record("DeleteAddressBulk", ctx, bulkRequest, baseFilters)
+	if m.DeleteAddressBulkFunc == nil {
+		r1 = notImplemented("addresses.Manager", "DeleteAddressBulk")
+		return
+	}
+	return m.DeleteAddressBulkFunc(ctx, bulkRequest, baseFilters)
+}
@@ -0,0 +1,172 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api"
+)
+
+var _ api.Manager = (*APIManagerMock)(nil)
+
+//APIManagerMock mocks api.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type APIManagerMock struct {
+	CallRecorder
+	GetCountriesFunc                func(ctx context.Context, filters map[string]string) ([]api.Country, error)
+	GetUserRightsFunc               func(ctx context.Context, filters map[string]string) ([]api.UserRights, error)
+	GetEmployeesFunc                func(ctx context.Context, filters map[string]string) ([]api.Employee, error)
+	GetEmployeesBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (api.GetEmployeesResponseBulk, error)
+	GetBusinessAreasFunc            func(ctx context.Context, filters map[string]string) ([]api.BusinessArea, error)
+	GetCurrenciesFunc               func(ctx context.Context, filters map[string]string) ([]api.Currency, error)
+	SaveEventFunc                   func(ctx context.Context, filters map[string]string) (int, error)
+	GetEventsFunc                   func(ctx context.Context, filters map[string]string) ([]api.Event, error)
+	LogProcessingOfCustomerDataFunc func(ctx context.Context, filters map[string]string) error
+	GetUserOperationsLogFunc        func(ctx context.Context, filters map[string]string) (*api.GetUserOperationsLogResponse, error)
+}
+
+//NewAPIManagerMock creates a mock where all methods return ErrNotImplemented
+func NewAPIManagerMock() *APIManagerMock {
+	return &APIManagerMock{
+		GetCountriesFunc: func(ctx context.Context, filters map[string]string) (r0 []api.Country, r1 error) {
+			r1 = notImplemented("api.Manager", "GetCountries")
+			return
+		},
+		GetUserRightsFunc: func(ctx context.Context, filters map[string]string) (r0 []api.UserRights, r1 error) {
+			r1 = notImplemented("api.Manager", "GetUserRights")
+			return
+		},
+		GetEmployeesFunc: func(ctx context.Context, filters map[string]string) (r0 []api.Employee, r1 error) {
+			r1 = notImplemented("api.Manager", "GetEmployees")
+			return
+		},
+		GetEmployeesBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 api.GetEmployeesResponseBulk, r1 error) {
+			r1 = notImplemented("api.Manager", "GetEmployeesBulk")
+			return
+		},
+		GetBusinessAreasFunc: func(ctx context.Context, filters map[string]string) (r0 []api.BusinessArea, r1 error) {
+			r1 = notImplemented("api.Manager", "GetBusinessAreas")
+			return
+		},
+		GetCurrenciesFunc: func(ctx context.Context, filters map[string]string) (r0 []api.Currency, r1 error) {
+			r1 = notImplemented("api.Manager", "GetCurrencies")
+			return
+		},
+		SaveEventFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("api.Manager", "SaveEvent")
+			return
+		},
+		GetEventsFunc: func(ctx context.Context, filters map[string]string) (r0 []api.Event, r1 error) {
+			r1 = notImplemented("api.Manager", "GetEvents")
+			return
+		},
+		LogProcessingOfCustomerDataFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("api.Manager", "LogProcessingOfCustomerData")
+			return
+		},
+		GetUserOperationsLogFunc: func(ctx context.Context, filters map[string]string) (r0 *api.GetUserOperationsLogResponse, r1 error) {
+			r1 = notImplemented("api.Manager", "GetUserOperationsLog")
+			return
+		},
+	}
+}
+
+//GetCountries calls GetCountriesFunc
+func (m *APIManagerMock) GetCountries(ctx context.Context, filters map[string]string) (r0 []api.Country, r1 error) {
+	m.record("GetCountries", ctx, filters)
+	if m.GetCountriesFunc == nil {
+		r1 = notImplemented("api.Manager", "GetCountries")
+		return
+	}
+	return m.GetCountriesFunc(ctx, filters)
+}
+
+//GetUserRights calls GetUserRightsFunc
+func (m *APIManagerMock) GetUserRights(ctx context.Context, filters map[string]string) (r0 []api.UserRights, r1 error) {
+	m.record("GetUserRights", ctx, filters)
+	if m.GetUserRightsFunc == nil {
+		r1 = notImplemented("api.Manager", "GetUserRights")
+		return
+	}
+	return m.GetUserRightsFunc(ctx, filters)
+}
+
+//GetEmployees calls GetEmployeesFunc
+func (m *APIManagerMock) GetEmployees(ctx context.Context, filters map[string]string) (r0 []api.Employee, r1 error) {
+	m.record("GetEmployees", ctx, filters)
+	if m.GetEmployeesFunc == nil {
+		r1 = notImplemented("api.Manager", "GetEmployees")
+		return
+	}
+	return m.GetEmployeesFunc(ctx, filters)
+}
+
+//GetEmployeesBulk calls GetEmployeesBulkFunc
+func (m *APIManagerMock) GetEmployeesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 api.GetEmployeesResponseBulk, r1 error) {
+	m.record("GetEmployeesBulk", ctx, bulkFilters, baseFilters)
+	if m.GetEmployeesBulkFunc == nil {
+		r1 = notImplemented("api.Manager", "GetEmployeesBulk")
+		return
+	}
+	return m.GetEmployeesBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetBusinessAreas calls GetBusinessAreasFunc
+func (m *APIManagerMock) GetBusinessAreas(ctx context.Context, filters map[string]string) (r0 []api.BusinessArea, r1 error) {
+	m.record("GetBusinessAreas", ctx, filters)
+	if m.GetBusinessAreasFunc == nil {
+		r1 = notImplemented("api.Manager", "GetBusinessAreas")
+		return
+	}
+	return m.GetBusinessAreasFunc(ctx, filters)
+}
+
+//GetCurrencies calls GetCurrenciesFunc
+func (m *APIManagerMock) GetCurrencies(ctx context.Context, filters map[string]string) (r0 []api.Currency, r1 error) {
+	m.record("GetCurrencies", ctx, filters)
+	if m.GetCurrenciesFunc == nil {
+		r1 = notImplemented("api.Manager", "GetCurrencies")
+		return
+	}
+	return m.GetCurrenciesFunc(ctx, filters)
+}
+
+//SaveEvent calls SaveEventFunc
+func (m *APIManagerMock) SaveEvent(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveEvent", ctx, filters)
+	if m.SaveEventFunc == nil {
+		r1 = notImplemented("api.Manager", "SaveEvent")
+		return
+	}
+	return m.SaveEventFunc(ctx, filters)
+}
+
+//GetEvents calls GetEventsFunc
+func (m *APIManagerMock) GetEvents(ctx context.Context, filters map[string]string) (r0 []api.Event, r1 error) {
+	m.record("GetEvents", ctx, filters)
+	if m.GetEventsFunc == nil {
+		r1 = notImplemented("api.Manager", "GetEvents")
+		return
+	}
+	return m.GetEventsFunc(ctx, filters)
+}
+
+//LogProcessingOfCustomerData calls LogProcessingOfCustomerDataFunc
+func (m *APIManagerMock) LogProcessingOfCustomerData(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("LogProcessingOfCustomerData", ctx, filters)
+	if m.LogProcessingOfCustomerDataFunc == nil {
+		r0 = notImplemented("api.Manager", "LogProcessingOfCustomerData")
+		return
+	}
+	return m.LogProcessingOfCustomerDataFunc(ctx, filters)
+}
+
+//GetUserOperationsLog calls GetUserOperationsLogFunc
+func (m *APIManagerMock) GetUserOperationsLog(ctx context.Context, filters map[string]string) (r0 *api.GetUserOperationsLogResponse, r1 error) {
+	m.record("GetUserOperationsLog", ctx, filters)
+	if m.GetUserOperationsLogFunc == nil {
+		r1 = notImplemented("api.Manager", "GetUserOperationsLog")
+		return
+	}
+	return m.GetUserOperationsLogFunc(ctx, filters)
+}
@@ -0,0 +1,37 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+)
+
+var _ auth.PartnerTokenProvider = (*PartnerTokenProviderMock)(nil)
+
+//PartnerTokenProviderMock mocks auth.PartnerTokenProvider, the calls are forwarded to the function fields and recorded with their arguments
+type PartnerTokenProviderMock struct {
+	CallRecorder
+	GetJWTTokenFunc func(ctx context.Context) (*auth.JwtToken, error)
+}
+
+//NewPartnerTokenProviderMock creates a mock where all methods return ErrNotImplemented
+func NewPartnerTokenProviderMock() *PartnerTokenProviderMock {
+	return &PartnerTokenProviderMock{
+		GetJWTTokenFunc: func(ctx context.Context) (r0 *auth.JwtToken, r1 error) {
+			r1 = notImplemented("auth.PartnerTokenProvider", "GetJWTToken")
+			return
+		},
+	}
+}
+
+//GetJWTToken calls GetJWTTokenFunc
+func (m *PartnerTokenProviderMock) GetJWTToken(ctx context.Context) (r0 *auth.JwtToken, r1 error) {
+	m.record("GetJWTToken", ctx)
+	if m.GetJWTTokenFunc == nil {
+		r1 = notImplemented("auth.PartnerTokenProvider", "GetJWTToken")
+		return
+	}
+	return m.GetJWTTokenFunc(ctx)
+}
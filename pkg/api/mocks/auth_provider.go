@@ -0,0 +1,67 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+)
+
+var _ auth.Provider = (*AuthProviderMock)(nil)
+
+//AuthProviderMock mocks auth.Provider, the calls are forwarded to the function fields and recorded with their arguments
+type AuthProviderMock struct {
+	CallRecorder
+	VerifyIdentityTokenFunc func(ctx context.Context, jwt string) (*auth.SessionInfo, error)
+	GetIdentityTokenFunc    func(ctx context.Context) (*auth.IdentityToken, error)
+	GetJWTTokenFunc         func(ctx context.Context) (*auth.JwtToken, error)
+}
+
+//NewAuthProviderMock creates a mock where all methods return ErrNotImplemented
+func NewAuthProviderMock() *AuthProviderMock {
+	return &AuthProviderMock{
+		VerifyIdentityTokenFunc: func(ctx context.Context, jwt string) (r0 *auth.SessionInfo, r1 error) {
+			r1 = notImplemented("auth.Provider", "VerifyIdentityToken")
+			return
+		},
+		GetIdentityTokenFunc: func(ctx context.Context) (r0 *auth.IdentityToken, r1 error) {
+			r1 = notImplemented("auth.Provider", "GetIdentityToken")
+			return
+		},
+		GetJWTTokenFunc: func(ctx context.Context) (r0 *auth.JwtToken, r1 error) {
+			r1 = notImplemented("auth.Provider", "GetJWTToken")
+			return
+		},
+	}
+}
+
+//VerifyIdentityToken calls VerifyIdentityTokenFunc
+func (m *AuthProviderMock) VerifyIdentityToken(ctx context.Context, jwt string) (r0 *auth.SessionInfo, r1 error) {
+	m.record("VerifyIdentityToken", ctx, jwt)
+	if m.VerifyIdentityTokenFunc == nil {
+		r1 = notImplemented("auth.Provider", "VerifyIdentityToken")
+		return
+	}
+	return m.VerifyIdentityTokenFunc(ctx, jwt)
+}
+
+//GetIdentityToken calls GetIdentityTokenFunc
+func (m *AuthProviderMock) GetIdentityToken(ctx context.Context) (r0 *auth.IdentityToken, r1 error) {
+	m.record("GetIdentityToken", ctx)
+	if m.GetIdentityTokenFunc == nil {
+		r1 = notImplemented("auth.Provider", "GetIdentityToken")
+		return
+	}
+	return m.GetIdentityTokenFunc(ctx)
+}
+
+//GetJWTToken calls GetJWTTokenFunc
+func (m *AuthProviderMock) GetJWTToken(ctx context.Context) (r0 *auth.JwtToken, r1 error) {
+	m.record("GetJWTToken", ctx)
+	if m.GetJWTTokenFunc == nil {
+		r1 = notImplemented("auth.Provider", "GetJWTToken")
+		return
+	}
+	return m.GetJWTTokenFunc(ctx)
+}
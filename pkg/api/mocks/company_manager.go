@@ -0,0 +1,67 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/company"
+)
+
+var _ company.Manager = (*CompanyManagerMock)(nil)
+
+//CompanyManagerMock mocks company.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type CompanyManagerMock struct {
+	CallRecorder
+	GetCompanyInfoFunc     func(ctx context.Context) (*company.Info, error)
+	GetConfParametersFunc  func(ctx context.Context) (*company.ConfParameter, error)
+	GetDefaultLanguageFunc func(ctx context.Context) (*company.Language, error)
+}
+
+//NewCompanyManagerMock creates a mock where all methods return ErrNotImplemented
+func NewCompanyManagerMock() *CompanyManagerMock {
+	return &CompanyManagerMock{
+		GetCompanyInfoFunc: func(ctx context.Context) (r0 *company.Info, r1 error) {
+			r1 = notImplemented("company.Manager", "GetCompanyInfo")
+			return
+		},
+		GetConfParametersFunc: func(ctx context.Context) (r0 *company.ConfParameter, r1 error) {
+			r1 = notImplemented("company.Manager", "GetConfParameters")
+			return
+		},
+		GetDefaultLanguageFunc: func(ctx context.Context) (r0 *company.Language, r1 error) {
+			r1 = notImplemented("company.Manager", "GetDefaultLanguage")
+			return
+		},
+	}
+}
+
+//GetCompanyInfo calls GetCompanyInfoFunc
+func (m *CompanyManagerMock) GetCompanyInfo(ctx context.Context) (r0 *company.Info, r1 error) {
+	m.record("GetCompanyInfo", ctx)
+	if m.GetCompanyInfoFunc == nil {
+		r1 = notImplemented("company.Manager", "GetCompanyInfo")
+		return
+	}
+	return m.GetCompanyInfoFunc(ctx)
+}
+
+//GetConfParameters calls GetConfParametersFunc
+func (m *CompanyManagerMock) GetConfParameters(ctx context.Context) (r0 *company.ConfParameter, r1 error) {
+	m.record("GetConfParameters", ctx)
+	if m.GetConfParametersFunc == nil {
+		r1 = notImplemented("company.Manager", "GetConfParameters")
+		return
+	}
+	return m.GetConfParametersFunc(ctx)
+}
+
+//GetDefaultLanguage calls GetDefaultLanguageFunc
+func (m *CompanyManagerMock) GetDefaultLanguage(ctx context.Context) (r0 *company.Language, r1 error) {
+	m.record("GetDefaultLanguage", ctx)
+	if m.GetDefaultLanguageFunc == nil {
+		r1 = notImplemented("company.Manager", "GetDefaultLanguage")
+		return
+	}
+	return m.GetDefaultLanguageFunc(ctx)
+}
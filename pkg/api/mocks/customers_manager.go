@@ -0,0 +1,352 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+)
+
+var _ customers.Manager = (*CustomersManagerMock)(nil)
+
+//CustomersManagerMock mocks customers.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type CustomersManagerMock struct {
+	CallRecorder
+	SaveCustomerFunc                func(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error)
+	SaveCustomerBulkFunc            func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.SaveCustomerResponseBulk, error)
+	GetCustomersFunc                func(ctx context.Context, filters map[string]string) ([]customers.Customer, error)
+	GetCustomersWithStatusFunc      func(ctx context.Context, filters map[string]string) (*customers.GetCustomersResponse, error)
+	GetCustomersBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetCustomersResponseBulk, error)
+	DeleteCustomerFunc              func(ctx context.Context, filters map[string]string) error
+	DeleteCustomerBulkFunc          func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.DeleteCustomersResponseBulk, error)
+	VerifyCustomerUserFunc          func(ctx context.Context, username string, password string) (*customers.WebshopClient, error)
+	ValidateCustomerUsernameFunc    func(ctx context.Context, username string) (bool, error)
+	GetCustomerGroupsFunc           func(ctx context.Context, filters map[string]string) ([]customers.CustomerGroup, error)
+	GetCustomerBalanceFunc          func(ctx context.Context, filters map[string]string) ([]customers.CustomerBalance, error)
+	GetSuppliersFunc                func(ctx context.Context, filters map[string]string) ([]customers.Supplier, error)
+	GetSuppliersBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetSuppliersResponseBulk, error)
+	SaveSupplierFunc                func(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error)
+	SaveSupplierBulkFunc            func(ctx context.Context, suppliers []map[string]interface{}, attrs map[string]string) (customers.SaveSuppliersResponseBulk, error)
+	DeleteSupplierFunc              func(ctx context.Context, filters map[string]string) error
+	DeleteSupplierBulkFunc          func(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (customers.DeleteSuppliersResponseBulk, error)
+	AddCustomerRewardPointsFunc     func(ctx context.Context, filters map[string]string) (customers.AddCustomerRewardPointsResult, error)
+	AddCustomerRewardPointsBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.AddCustomerRewardPointsResponseBulk, error)
+	GetCompanyTypesFunc             func(ctx context.Context, filters map[string]string) ([]customers.CompanyType, error)
+	SaveCompanyTypeFunc             func(ctx context.Context, filters map[string]string) (*customers.SaveCompanyTypeResponse, error)
+	SaveSupplierGroupFunc           func(ctx context.Context, filters map[string]string) (*customers.SaveSupplierGroupResponse, error)
+}
+
+//NewCustomersManagerMock creates a mock where all methods return ErrNotImplemented
+func NewCustomersManagerMock() *CustomersManagerMock {
+	return &CustomersManagerMock{
+		SaveCustomerFunc: func(ctx context.Context, filters map[string]string) (r0 *customers.CustomerImportReport, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveCustomer")
+			return
+		},
+		SaveCustomerBulkFunc: func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (r0 customers.SaveCustomerResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveCustomerBulk")
+			return
+		},
+		GetCustomersFunc: func(ctx context.Context, filters map[string]string) (r0 []customers.Customer, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCustomers")
+			return
+		},
+		GetCustomersWithStatusFunc: func(ctx context.Context, filters map[string]string) (r0 *customers.GetCustomersResponse, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCustomersWithStatus")
+			return
+		},
+		GetCustomersBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.GetCustomersResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCustomersBulk")
+			return
+		},
+		DeleteCustomerFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("customers.Manager", "DeleteCustomer")
+			return
+		},
+		DeleteCustomerBulkFunc: func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (r0 customers.DeleteCustomersResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "DeleteCustomerBulk")
+			return
+		},
+		VerifyCustomerUserFunc: func(ctx context.Context, username string, password string) (r0 *customers.WebshopClient, r1 error) {
+			r1 = notImplemented("customers.Manager", "VerifyCustomerUser")
+			return
+		},
+		ValidateCustomerUsernameFunc: func(ctx context.Context, username string) (r0 bool, r1 error) {
+			r1 = notImplemented("customers.Manager", "ValidateCustomerUsername")
+			return
+		},
+		GetCustomerGroupsFunc: func(ctx context.Context, filters map[string]string) (r0 []customers.CustomerGroup, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCustomerGroups")
+			return
+		},
+		GetCustomerBalanceFunc: func(ctx context.Context, filters map[string]string) (r0 []customers.CustomerBalance, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCustomerBalance")
+			return
+		},
+		GetSuppliersFunc: func(ctx context.Context, filters map[string]string) (r0 []customers.Supplier, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetSuppliers")
+			return
+		},
+		GetSuppliersBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.GetSuppliersResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetSuppliersBulk")
+			return
+		},
+		SaveSupplierFunc: func(ctx context.Context, filters map[string]string) (r0 *customers.CustomerImportReport, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveSupplier")
+			return
+		},
+		SaveSupplierBulkFunc: func(ctx context.Context, suppliers []map[string]interface{}, attrs map[string]string) (r0 customers.SaveSuppliersResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveSupplierBulk")
+			return
+		},
+		DeleteSupplierFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("customers.Manager", "DeleteSupplier")
+			return
+		},
+		DeleteSupplierBulkFunc: func(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (r0 customers.DeleteSuppliersResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "DeleteSupplierBulk")
+			return
+		},
+		AddCustomerRewardPointsFunc: func(ctx context.Context, filters map[string]string) (r0 customers.AddCustomerRewardPointsResult, r1 error) {
+			r1 = notImplemented("customers.Manager", "AddCustomerRewardPoints")
+			return
+		},
+		AddCustomerRewardPointsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.AddCustomerRewardPointsResponseBulk, r1 error) {
+			r1 = notImplemented("customers.Manager", "AddCustomerRewardPointsBulk")
+			return
+		},
+		GetCompanyTypesFunc: func(ctx context.Context, filters map[string]string) (r0 []customers.CompanyType, r1 error) {
+			r1 = notImplemented("customers.Manager", "GetCompanyTypes")
+			return
+		},
+		SaveCompanyTypeFunc: func(ctx context.Context, filters map[string]string) (r0 *customers.SaveCompanyTypeResponse, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveCompanyType")
+			return
+		},
+		SaveSupplierGroupFunc: func(ctx context.Context, filters map[string]string) (r0 *customers.SaveSupplierGroupResponse, r1 error) {
+			r1 = notImplemented("customers.Manager", "SaveSupplierGroup")
+			return
+		},
+	}
+}
+
+//SaveCustomer calls SaveCustomerFunc
+func (m *CustomersManagerMock) SaveCustomer(ctx context.Context, filters map[string]string) (r0 *customers.CustomerImportReport, r1 error) {
+	m.record("SaveCustomer", ctx, filters)
+	if m.SaveCustomerFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveCustomer")
+		return
+	}
+	return m.SaveCustomerFunc(ctx, filters)
+}
+
+//SaveCustomerBulk calls SaveCustomerBulkFunc
+func (m *CustomersManagerMock) SaveCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (r0 customers.SaveCustomerResponseBulk, r1 error) {
+	m.record("SaveCustomerBulk", ctx, customerMap, attrs)
+	if m.SaveCustomerBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveCustomerBulk")
+		return
+	}
+	return m.SaveCustomerBulkFunc(ctx, customerMap, attrs)
+}
+
+//GetCustomers calls GetCustomersFunc
+func (m *CustomersManagerMock) GetCustomers(ctx context.Context, filters map[string]string) (r0 []customers.Customer, r1 error) {
+	m.record("GetCustomers", ctx, filters)
+	if m.GetCustomersFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCustomers")
+		return
+	}
+	return m.GetCustomersFunc(ctx, filters)
+}
+
+//GetCustomersWithStatus calls GetCustomersWithStatusFunc
+func (m *CustomersManagerMock) GetCustomersWithStatus(ctx context.Context, filters map[string]string) (r0 *customers.GetCustomersResponse, r1 error) {
+	m.record("GetCustomersWithStatus", ctx, filters)
+	if m.GetCustomersWithStatusFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCustomersWithStatus")
+		return
+	}
+	return m.GetCustomersWithStatusFunc(ctx, filters)
+}
+
+//GetCustomersBulk calls GetCustomersBulkFunc
+func (m *CustomersManagerMock) GetCustomersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.GetCustomersResponseBulk, r1 error) {
+	m.record("GetCustomersBulk", ctx, bulkFilters, baseFilters)
+	if m.GetCustomersBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCustomersBulk")
+		return
+	}
+	return m.GetCustomersBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteCustomer calls DeleteCustomerFunc
+func (m *CustomersManagerMock) DeleteCustomer(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteCustomer", ctx, filters)
+	if m.DeleteCustomerFunc == nil {
+		r0 = notImplemented("customers.Manager", "DeleteCustomer")
+		return
+	}
+	return m.DeleteCustomerFunc(ctx, filters)
+}
+
+//DeleteCustomerBulk calls DeleteCustomerBulkFunc
+func (m *CustomersManagerMock) DeleteCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (r0 customers.DeleteCustomersResponseBulk, r1 error) {
+	m.record("DeleteCustomerBulk", ctx, customerMap, attrs)
+	if m.DeleteCustomerBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "DeleteCustomerBulk")
+		return
+	}
+	return m.DeleteCustomerBulkFunc(ctx, customerMap, attrs)
+}
+
+//VerifyCustomerUser calls VerifyCustomerUserFunc
+func (m *CustomersManagerMock) VerifyCustomerUser(ctx context.Context, username string, password string) (r0 *customers.WebshopClient, r1 error) {
+	m.record("VerifyCustomerUser", ctx, username, password)
+	if m.VerifyCustomerUserFunc == nil {
+		r1 = notImplemented("customers.Manager", "VerifyCustomerUser")
+		return
+	}
+	return m.VerifyCustomerUserFunc(ctx, username, password)
+}
+
+//ValidateCustomerUsername calls ValidateCustomerUsernameFunc
+func (m *CustomersManagerMock) ValidateCustomerUsername(ctx context.Context, username string) (r0 bool, r1 error) {
+	m.record("ValidateCustomerUsername", ctx, username)
+	if m.ValidateCustomerUsernameFunc == nil {
+		r1 = notImplemented("customers.Manager", "ValidateCustomerUsername")
+		return
+	}
+	return m.ValidateCustomerUsernameFunc(ctx, username)
+}
+
+//GetCustomerGroups calls GetCustomerGroupsFunc
+func (m *CustomersManagerMock) GetCustomerGroups(ctx context.Context, filters map[string]string) (r0 []customers.CustomerGroup, r1 error) {
+	m.record("GetCustomerGroups", ctx, filters)
+	if m.GetCustomerGroupsFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCustomerGroups")
+		return
+	}
+	return m.GetCustomerGroupsFunc(ctx, filters)
+}
+
+//GetCustomerBalance calls GetCustomerBalanceFunc
+func (m *CustomersManagerMock) GetCustomerBalance(ctx context.Context, filters map[string]string) (r0 []customers.CustomerBalance, r1 error) {
+	m.record("GetCustomerBalance", ctx, filters)
+	if m.GetCustomerBalanceFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCustomerBalance")
+		return
+	}
+	return m.GetCustomerBalanceFunc(ctx, filters)
+}
+
+//GetSuppliers calls GetSuppliersFunc
+func (m *CustomersManagerMock) GetSuppliers(ctx context.Context, filters map[string]string) (r0 []customers.Supplier, r1 error) {
+	m.record("GetSuppliers", ctx, filters)
+	if m.GetSuppliersFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetSuppliers")
+		return
+	}
+	return m.GetSuppliersFunc(ctx, filters)
+}
+
+//GetSuppliersBulk calls GetSuppliersBulkFunc
+func (m *CustomersManagerMock) GetSuppliersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.GetSuppliersResponseBulk, r1 error) {
+	m.record("GetSuppliersBulk", ctx, bulkFilters, baseFilters)
+	if m.GetSuppliersBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetSuppliersBulk")
+		return
+	}
+	return m.GetSuppliersBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveSupplier calls SaveSupplierFunc
+func (m *CustomersManagerMock) SaveSupplier(ctx context.Context, filters map[string]string) (r0 *customers.CustomerImportReport, r1 error) {
+	m.record("SaveSupplier", ctx, filters)
+	if m.SaveSupplierFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveSupplier")
+		return
+	}
+	return m.SaveSupplierFunc(ctx, filters)
+}
+
+//SaveSupplierBulk calls SaveSupplierBulkFunc
+func (m *CustomersManagerMock) SaveSupplierBulk(ctx context.Context, suppliers []map[string]interface{}, attrs map[string]string) (r0 customers.SaveSuppliersResponseBulk, r1 error) {
+	m.record("SaveSupplierBulk", ctx, suppliers, attrs)
+	if m.SaveSupplierBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveSupplierBulk")
+		return
+	}
+	return m.SaveSupplierBulkFunc(ctx, suppliers, attrs)
+}
+
+//DeleteSupplier calls DeleteSupplierFunc
+func (m *CustomersManagerMock) DeleteSupplier(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteSupplier", ctx, filters)
+	if m.DeleteSupplierFunc == nil {
+		r0 = notImplemented("customers.Manager", "DeleteSupplier")
+		return
+	}
+	return m.DeleteSupplierFunc(ctx, filters)
+}
+
+//DeleteSupplierBulk calls DeleteSupplierBulkFunc
+func (m *CustomersManagerMock) DeleteSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (r0 customers.DeleteSuppliersResponseBulk, r1 error) {
+	m.record("DeleteSupplierBulk", ctx, supplierMap, attrs)
+	if m.DeleteSupplierBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "DeleteSupplierBulk")
+		return
+	}
+	return m.DeleteSupplierBulkFunc(ctx, supplierMap, attrs)
+}
+
+//AddCustomerRewardPoints calls AddCustomerRewardPointsFunc
+func (m *CustomersManagerMock) AddCustomerRewardPoints(ctx context.Context, filters map[string]string) (r0 customers.AddCustomerRewardPointsResult, r1 error) {
+	m.record("AddCustomerRewardPoints", ctx, filters)
+	if m.AddCustomerRewardPointsFunc == nil {
+		r1 = notImplemented("customers.Manager", "AddCustomerRewardPoints")
+		return
+	}
+	return m.AddCustomerRewardPointsFunc(ctx, filters)
+}
+
+//AddCustomerRewardPointsBulk calls AddCustomerRewardPointsBulkFunc
+func (m *CustomersManagerMock) AddCustomerRewardPointsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 customers.AddCustomerRewardPointsResponseBulk, r1 error) {
+	m.record("AddCustomerRewardPointsBulk", ctx, bulkFilters, baseFilters)
+	if m.AddCustomerRewardPointsBulkFunc == nil {
+		r1 = notImplemented("customers.Manager", "AddCustomerRewardPointsBulk")
+		return
+	}
+	return m.AddCustomerRewardPointsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetCompanyTypes calls GetCompanyTypesFunc
+func (m *CustomersManagerMock) GetCompanyTypes(ctx context.Context, filters map[string]string) (r0 []customers.CompanyType, r1 error) {
+	m.record("GetCompanyTypes", ctx, filters)
+	if m.GetCompanyTypesFunc == nil {
+		r1 = notImplemented("customers.Manager", "GetCompanyTypes")
+		return
+	}
+	return m.GetCompanyTypesFunc(ctx, filters)
+}
+
+//SaveCompanyType calls SaveCompanyTypeFunc
+func (m *CustomersManagerMock) SaveCompanyType(ctx context.Context, filters map[string]string) (r0 *customers.SaveCompanyTypeResponse, r1 error) {
+	m.record("SaveCompanyType", ctx, filters)
+	if m.SaveCompanyTypeFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveCompanyType")
+		return
+	}
+	return m.SaveCompanyTypeFunc(ctx, filters)
+}
+
+//SaveSupplierGroup calls SaveSupplierGroupFunc
+func (m *CustomersManagerMock) SaveSupplierGroup(ctx context.Context, filters map[string]string) (r0 *customers.SaveSupplierGroupResponse, r1 error) {
+	m.record("SaveSupplierGroup", ctx, filters)
+	if m.SaveSupplierGroupFunc == nil {
+		r1 = notImplemented("customers.Manager", "SaveSupplierGroup")
+		return
+	}
+	return m.SaveSupplierGroupFunc(ctx, filters)
+}
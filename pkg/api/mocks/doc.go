@@ -0,0 +1,9 @@
+//Package mocks contains mocks of the interfaces of this library for the unit tests of the applications using it.
+//Each mock has a function field per interface method, e.g. ProductsManagerMock.GetProductsFunc, which can be set
+//to return the needed data. The calls are recorded with their arguments by the embedded CallRecorder.
+//The constructors like NewProductsManagerMock create mocks where all methods return ErrNotImplemented.
+//
+//The mocks are generated from the interfaces with go generate, they should be regenerated after the interfaces change.
+package mocks
+
+//go:generate go run ./gen .. .
@@ -0,0 +1,67 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/documents"
+)
+
+var _ documents.Manager = (*DocumentsManagerMock)(nil)
+
+//DocumentsManagerMock mocks documents.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type DocumentsManagerMock struct {
+	CallRecorder
+	GetPurchaseDocumentsFunc           func(ctx context.Context, filters map[string]string) ([]documents.PurchaseDocument, error)
+	GetPurchaseDocumentsWithStatusFunc func(ctx context.Context, filters map[string]string) (documents.GetPurchaseDocumentsResponse, error)
+	GetPurchaseDocumentsBulkFunc       func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (documents.GetPurchaseDocumentResponseBulk, error)
+}
+
+//NewDocumentsManagerMock creates a mock where all methods return ErrNotImplemented
+func NewDocumentsManagerMock() *DocumentsManagerMock {
+	return &DocumentsManagerMock{
+		GetPurchaseDocumentsFunc: func(ctx context.Context, filters map[string]string) (r0 []documents.PurchaseDocument, r1 error) {
+			r1 = notImplemented("documents.Manager", "GetPurchaseDocuments")
+			return
+		},
+		GetPurchaseDocumentsWithStatusFunc: func(ctx context.Context, filters map[string]string) (r0 documents.GetPurchaseDocumentsResponse, r1 error) {
+			r1 = notImplemented("documents.Manager", "GetPurchaseDocumentsWithStatus")
+			return
+		},
+		GetPurchaseDocumentsBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 documents.GetPurchaseDocumentResponseBulk, r1 error) {
+			r1 = notImplemented("documents.Manager", "GetPurchaseDocumentsBulk")
+			return
+		},
+	}
+}
+
+//GetPurchaseDocuments calls GetPurchaseDocumentsFunc
+func (m *DocumentsManagerMock) GetPurchaseDocuments(ctx context.Context, filters map[string]string) (r0 []documents.PurchaseDocument, r1 error) {
+	m.record("GetPurchaseDocuments", ctx, filters)
+	if m.GetPurchaseDocumentsFunc == nil {
+		r1 = notImplemented("documents.Manager", "GetPurchaseDocuments")
+		return
+	}
+	return m.GetPurchaseDocumentsFunc(ctx, filters)
+}
+
+//GetPurchaseDocumentsWithStatus calls GetPurchaseDocumentsWithStatusFunc
+func (m *DocumentsManagerMock) GetPurchaseDocumentsWithStatus(ctx context.Context, filters map[string]string) (r0 documents.GetPurchaseDocumentsResponse, r1 error) {
+	m.record("GetPurchaseDocumentsWithStatus", ctx, filters)
+	if m.GetPurchaseDocumentsWithStatusFunc == nil {
+		r1 = notImplemented("documents.Manager", "GetPurchaseDocumentsWithStatus")
+		return
+	}
+	return m.GetPurchaseDocumentsWithStatusFunc(ctx, filters)
+}
+
+//GetPurchaseDocumentsBulk calls GetPurchaseDocumentsBulkFunc
+func (m *DocumentsManagerMock) GetPurchaseDocumentsBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 documents.GetPurchaseDocumentResponseBulk, r1 error) {
+	m.record("GetPurchaseDocumentsBulk", ctx, bulkRequest, baseFilters)
+	if m.GetPurchaseDocumentsBulkFunc == nil {
+		r1 = notImplemented("documents.Manager", "GetPurchaseDocumentsBulk")
+		return
+	}
+	return m.GetPurchaseDocumentsBulkFunc(ctx, bulkRequest, baseFilters)
+}
@@ -0,0 +1,506 @@
+//Command gen generates the mocks of the API interfaces, run it with go generate in the mocks package
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/erply/api-go-wrapper"
+
+//target is an interface which gets a mock
+type target struct {
+	//dir is the package directory relative to pkg/api
+	dir       string
+	iface     string
+	mockName  string
+	fileName  string
+	pkgName   string
+	pkgImport string
+}
+
+var targets = []target{
+	{dir: ".", iface: "Manager", mockName: "APIManagerMock", fileName: "api_manager.go"},
+	{dir: "addresses", iface: "Manager", mockName: "AddressesManagerMock", fileName: "addresses_manager.go"},
+	{dir: "auth", iface: "Provider", mockName: "AuthProviderMock", fileName: "auth_provider.go"},
+	{dir: "auth", iface: "PartnerTokenProvider", mockName: "PartnerTokenProviderMock", fileName: "auth_partner_token_provider.go"},
+	{dir: "company", iface: "Manager", mockName: "CompanyManagerMock", fileName: "company_manager.go"},
+	{dir: "customers", iface: "Manager", mockName: "CustomersManagerMock", fileName: "customers_manager.go"},
+	{dir: "documents", iface: "Manager", mockName: "DocumentsManagerMock", fileName: "documents_manager.go"},
+	{dir: "pos", iface: "Manager", mockName: "PosManagerMock", fileName: "pos_manager.go"},
+	{dir: "prices", iface: "Manager", mockName: "PricesManagerMock", fileName: "prices_manager.go"},
+	{dir: "products", iface: "Manager", mockName: "ProductsManagerMock", fileName: "products_manager.go"},
+	{dir: "sales", iface: "Manager", mockName: "SalesManagerMock", fileName: "sales_manager.go"},
+	{dir: "servicediscovery", iface: "Manager", mockName: "ServiceDiscoveryManagerMock", fileName: "servicediscovery_manager.go"},
+	{dir: "warehouse", iface: "Manager", mockName: "WarehouseManagerMock", fileName: "warehouse_manager.go"},
+	{dir: "warehouse", iface: "InventoryManager", mockName: "InventoryManagerMock", fileName: "warehouse_inventory_manager.go"},
+}
+
+//importAliases are the names of the imported packages in the generated code
+var importAliases = map[string]string{
+	"context":                       "context",
+	modulePath + "/pkg/api":         "api",
+	modulePath + "/pkg/api/common":  "sharedCommon",
+	modulePath + "/internal/common": "common",
+}
+
+type method struct {
+	name    string
+	params  []field
+	results []field
+}
+
+type field struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+//pkgSource is a parsed package
+type pkgSource struct {
+	name       string
+	importPath string
+	interfaces map[string]*ast.InterfaceType
+	//imports per interface name, the aliases are resolved in the file where the interface is declared
+	fileImports map[string]map[string]string
+}
+
+func main() {
+	apiDir := filepath.Join("..")
+	if len(os.Args) > 1 {
+		apiDir = os.Args[1]
+	}
+	outDir := "."
+	if len(os.Args) > 2 {
+		outDir = os.Args[2]
+	}
+
+	if err := generate(apiDir, outDir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(apiDir, outDir string) error {
+	sources := map[string]*pkgSource{}
+	for _, t := range targets {
+		src, ok := sources[t.dir]
+		if !ok {
+			var err error
+			src, err = parsePackage(filepath.Join(apiDir, t.dir), t.dir)
+			if err != nil {
+				return err
+			}
+			sources[t.dir] = src
+		}
+
+		t.pkgName = src.name
+		t.pkgImport = src.importPath
+		code, err := generateMock(t, src)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", src.name, t.iface, err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(outDir, t.fileName), code, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parsePackage(dir, relDir string) (*pkgSource, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath := modulePath + "/pkg/api"
+	if relDir != "." {
+		importPath += "/" + filepath.ToSlash(relDir)
+	}
+
+	for name, pkg := range pkgs {
+		src := &pkgSource{
+			name:        name,
+			importPath:  importPath,
+			interfaces:  map[string]*ast.InterfaceType{},
+			fileImports: map[string]map[string]string{},
+		}
+
+		for _, file := range pkg.Files {
+			imports := map[string]string{}
+			for _, imp := range file.Imports {
+				path, _ := strconv.Unquote(imp.Path.Value)
+				alias := filepath.Base(path)
+				if imp.Name != nil {
+					alias = imp.Name.Name
+				}
+				imports[alias] = path
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				typeSpec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				if ifaceType, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+					src.interfaces[typeSpec.Name.Name] = ifaceType
+					src.fileImports[typeSpec.Name.Name] = imports
+				}
+				return false
+			})
+		}
+
+		return src, nil
+	}
+
+	return nil, fmt.Errorf("no package in %s", dir)
+}
+
+//collectMethods gives the methods of the interface including the ones of the embedded interfaces of the same package
+func collectMethods(src *pkgSource, ifaceName string, usedImports map[string]bool) ([]method, error) {
+	ifaceType, ok := src.interfaces[ifaceName]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", ifaceName)
+	}
+	imports := src.fileImports[ifaceName]
+
+	var methods []method
+	for _, m := range ifaceType.Methods.List {
+		funcType, ok := m.Type.(*ast.FuncType)
+		if !ok {
+			embedded, ok := m.Type.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("unsupported embedded interface %T", m.Type)
+			}
+			embeddedMethods, err := collectMethods(src, embedded.Name, usedImports)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embeddedMethods...)
+			continue
+		}
+
+		tw := typeWriter{src: src, imports: imports, used: usedImports}
+		params, err := tw.fields(funcType.Params)
+		if err != nil {
+			return nil, err
+		}
+		results, err := tw.fields(funcType.Results)
+		if err != nil {
+			return nil, err
+		}
+
+		methods = append(methods, method{name: m.Names[0].Name, params: params, results: results})
+	}
+
+	return methods, nil
+}
+
+type typeWriter struct {
+	src     *pkgSource
+	imports map[string]string
+	used    map[string]bool
+}
+
+func (tw typeWriter) fields(list *ast.FieldList) ([]field, error) {
+	var res []field
+	if list == nil {
+		return res, nil
+	}
+
+	for _, f := range list.List {
+		variadic := false
+		typeExpr := f.Type
+		if ellipsis, ok := typeExpr.(*ast.Ellipsis); ok {
+			variadic = true
+			typeExpr = ellipsis.Elt
+		}
+		typ, err := tw.expr(typeExpr)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(f.Names) == 0 {
+			res = append(res, field{typ: typ, variadic: variadic})
+			continue
+		}
+		for _, name := range f.Names {
+			res = append(res, field{name: name.Name, typ: typ, variadic: variadic})
+		}
+	}
+
+	return res, nil
+}
+
+func (tw typeWriter) expr(e ast.Expr) (string, error) {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(t.Name[0])) {
+			tw.used[tw.src.importPath] = true
+			return alias(tw.src.importPath) + "." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkgIdent, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector %T", t.X)
+		}
+		path, ok := tw.imports[pkgIdent.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", pkgIdent.Name)
+		}
+		tw.used[path] = true
+		return alias(path) + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		inner, err := tw.expr(t.X)
+		return "*" + inner, err
+	case *ast.ArrayType:
+		inner, err := tw.expr(t.Elt)
+		if t.Len != nil {
+			return "", fmt.Errorf("arrays are not supported")
+		}
+		return "[]" + inner, err
+	case *ast.MapType:
+		key, err := tw.expr(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := tw.expr(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("only empty interfaces are supported")
+		}
+		return "interface{}", nil
+	case *ast.ChanType:
+		inner, err := tw.expr(t.Value)
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + inner, err
+		case ast.RECV:
+			return "<-chan " + inner, err
+		default:
+			return "chan " + inner, err
+		}
+	}
+
+	return "", fmt.Errorf("unsupported type %T", e)
+}
+
+func alias(importPath string) string {
+	if a, ok := importAliases[importPath]; ok {
+		return a
+	}
+
+	return filepath.Base(importPath)
+}
+
+func generateMock(t target, src *pkgSource) ([]byte, error) {
+	usedImports := map[string]bool{t.pkgImport: true}
+	methods, err := collectMethods(src, t.iface, usedImports)
+	if err != nil {
+		return nil, err
+	}
+
+	ifaceRef := alias(t.pkgImport) + "." + t.iface
+	reserved := map[string]bool{"m": true}
+	for path := range usedImports {
+		reserved[alias(path)] = true
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by mocks/gen. DO NOT EDIT.\n\npackage mocks\n\nimport (\n")
+	paths := make([]string, 0, len(usedImports))
+	for path := range usedImports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for i, path := range paths {
+		if i > 0 && !strings.Contains(paths[i-1], ".") && strings.Contains(path, ".") {
+			fmt.Fprintf(buf, "\n")
+		}
+		if alias(path) == filepath.Base(path) {
+			fmt.Fprintf(buf, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(buf, "\t%s %q\n", alias(path), path)
+		}
+	}
+	fmt.Fprintf(buf, ")\n\n")
+
+	fmt.Fprintf(buf, "var _ %s = (*%s)(nil)\n\n", ifaceRef, t.mockName)
+
+	fmt.Fprintf(buf, "//%s mocks %s, the calls are forwarded to the function fields and recorded with their arguments\n", t.mockName, ifaceRef)
+	fmt.Fprintf(buf, "type %s struct {\n\tCallRecorder\n", t.mockName)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func%s\n", m.name, signature(m, reserved))
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "//New%s creates a mock where all methods return ErrNotImplemented\n", t.mockName)
+	fmt.Fprintf(buf, "func New%s() *%s {\n\treturn &%s{\n", t.mockName, t.mockName, t.mockName)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t\t%sFunc: func%s {\n", m.name, namedSignature(m, reserved))
+		fmt.Fprintf(buf, "%s\t\t},\n", notImplementedBody(m, ifaceRef, "\t\t\t"))
+	}
+	fmt.Fprintf(buf, "\t}\n}\n")
+
+	for _, m := range methods {
+		params := paramNames(m, reserved)
+		fmt.Fprintf(buf, "\n//%s calls %sFunc\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (m *%s) %s%s {\n", t.mockName, m.name, namedSignature(m, reserved))
+		fmt.Fprintf(buf, "\tm.record(%q%s)\n", m.name, recordArgs(params))
+		fmt.Fprintf(buf, "\tif m.%sFunc == nil {\n", m.name)
+		fmt.Fprintf(buf, "%s\t}\n", notImplementedBody(m, ifaceRef, "\t\t"))
+		callArgs := make([]string, len(params))
+		for i, p := range params {
+			callArgs[i] = p
+			if m.params[i].variadic {
+				callArgs[i] += "..."
+			}
+		}
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(callArgs, ", "))
+		if len(m.results) == 0 {
+			fmt.Fprintf(buf, "\t%s\n}\n", call)
+		} else {
+			fmt.Fprintf(buf, "\treturn %s\n}\n", call)
+		}
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, buf.String())
+	}
+
+	//the comments are written without the space like in the rest of the library
+	lines := strings.Split(string(code), "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "// ") {
+			lines[i] = "//" + strings.TrimPrefix(lines[i], "// ")
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+func paramNames(m method, reserved map[string]bool) []string {
+	names := make([]string, len(m.params))
+	for i, p := range m.params {
+		if p.name == "" || p.name == "_" || reserved[p.name] {
+			names[i] = fmt.Sprintf("arg%d", i)
+		} else {
+			names[i] = p.name
+		}
+	}
+
+	return names
+}
+
+func resultNames(m method, reserved map[string]bool) []string {
+	names := make([]string, len(m.results))
+	for i := range m.results {
+		names[i] = fmt.Sprintf("r%d", i)
+	}
+
+	return names
+}
+
+func paramTypes(m method) []string {
+	types := make([]string, len(m.params))
+	for i, p := range m.params {
+		types[i] = p.typ
+		if p.variadic {
+			types[i] = "..." + p.typ
+		}
+	}
+
+	return types
+}
+
+//signature gives the function type of the method with the original parameter names
+func signature(m method, reserved map[string]bool) string {
+	names := paramNames(m, reserved)
+	types := paramTypes(m)
+	params := make([]string, len(names))
+	for i := range names {
+		params[i] = names[i] + " " + types[i]
+	}
+
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = r.typ
+	}
+
+	return "(" + strings.Join(params, ", ") + ")" + resultsString(results)
+}
+
+//namedSignature gives the function type with named results, so zero values can be returned without knowing the types
+func namedSignature(m method, reserved map[string]bool) string {
+	names := paramNames(m, reserved)
+	types := paramTypes(m)
+	params := make([]string, len(names))
+	for i := range names {
+		params[i] = names[i] + " " + types[i]
+	}
+
+	rNames := resultNames(m, reserved)
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = rNames[i] + " " + r.typ
+	}
+
+	res := "(" + strings.Join(params, ", ") + ")"
+	if len(results) > 0 {
+		res += " (" + strings.Join(results, ", ") + ")"
+	}
+
+	return res
+}
+
+func resultsString(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	default:
+		return " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+func notImplementedBody(m method, ifaceRef, indent string) string {
+	if len(m.results) == 0 {
+		return indent + "return\n"
+	}
+
+	body := ""
+	last := len(m.results) - 1
+	if m.results[last].typ == "error" {
+		body += fmt.Sprintf("%sr%d = notImplemented(%q, %q)\n", indent, last, ifaceRef, m.name)
+	}
+
+	return body + indent + "return\n"
+}
+
+func recordArgs(params []string) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	return ", " + strings.Join(params, ", ")
+}
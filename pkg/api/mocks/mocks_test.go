@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/erply/api-go-wrapper/pkg/api"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductsManagerMock(t *testing.T) {
+	mock := NewProductsManagerMock()
+	mock.GetProductsBulkFunc = func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductsResponseBulk, error) {
+		return products.GetProductsResponseBulk{
+			BulkItems: []products.GetProductsResponseBulkItem{
+				{
+					Status:   sharedCommon.StatusBulk{Status: sharedCommon.Status{RecordsTotal: 2}},
+					Products: []products.Product{{ProductID: 1}, {ProductID: 2}},
+				},
+			},
+		}, nil
+	}
+
+	cli := &api.Client{ProductManager: mock}
+	provider := products.NewListingDataProvider(cli.ProductManager)
+
+	count, err := provider.Count(context.Background(), map[string]interface{}{"groupID": 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var ids []int
+	err = provider.Read(context.Background(), []map[string]interface{}{{"pageNo": 1}}, func(item interface{}) {
+		ids = append(ids, item.(products.Product).ProductID)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids)
+
+	calls := mock.CallsOf("GetProductsBulk")
+	assert.Len(t, calls, 2)
+	assert.Equal(t, []map[string]interface{}{{"groupID": 3, "recordsOnPage": 1, "pageNo": 1}}, calls[0].Args[1])
+	assert.Equal(t, 2, mock.CallsCount("GetProductsBulk"))
+
+	_, err = mock.GetProducts(context.Background(), nil)
+	assert.True(t, errors.Is(err, ErrNotImplemented))
+	assert.Contains(t, err.Error(), "products.Manager.GetProducts")
+	assert.Len(t, mock.Calls(), 3)
+
+	mock.Reset()
+	assert.Len(t, mock.Calls(), 0)
+}
+
+func TestNilFuncReturnsNotImplemented(t *testing.T) {
+	mock := &WarehouseManagerMock{}
+
+	_, err := mock.GetWarehouses(context.Background(), map[string]string{"warehouseID": "1"})
+	assert.True(t, errors.Is(err, ErrNotImplemented))
+	assert.Equal(t, 1, mock.CallsCount("GetWarehouses"))
+}
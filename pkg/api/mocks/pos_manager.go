@@ -0,0 +1,52 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/pos"
+)
+
+var _ pos.Manager = (*PosManagerMock)(nil)
+
+//PosManagerMock mocks pos.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type PosManagerMock struct {
+	CallRecorder
+	GetPointsOfSaleFunc func(ctx context.Context, filters map[string]string) ([]pos.PointOfSale, error)
+	GetClockInsFunc     func(ctx context.Context, filters map[string]string) ([]pos.Clocking, error)
+}
+
+//NewPosManagerMock creates a mock where all methods return ErrNotImplemented
+func NewPosManagerMock() *PosManagerMock {
+	return &PosManagerMock{
+		GetPointsOfSaleFunc: func(ctx context.Context, filters map[string]string) (r0 []pos.PointOfSale, r1 error) {
+			r1 = notImplemented("pos.Manager", "GetPointsOfSale")
+			return
+		},
+		GetClockInsFunc: func(ctx context.Context, filters map[string]string) (r0 []pos.Clocking, r1 error) {
+			r1 = notImplemented("pos.Manager", "GetClockIns")
+			return
+		},
+	}
+}
+
+//GetPointsOfSale calls GetPointsOfSaleFunc
+func (m *PosManagerMock) GetPointsOfSale(ctx context.Context, filters map[string]string) (r0 []pos.PointOfSale, r1 error) {
+	m.record("GetPointsOfSale", ctx, filters)
+	if m.GetPointsOfSaleFunc == nil {
+		r1 = notImplemented("pos.Manager", "GetPointsOfSale")
+		return
+	}
+	return m.GetPointsOfSaleFunc(ctx, filters)
+}
+
+//GetClockIns calls GetClockInsFunc
+func (m *PosManagerMock) GetClockIns(ctx context.Context, filters map[string]string) (r0 []pos.Clocking, r1 error) {
+	m.record("GetClockIns", ctx, filters)
+	if m.GetClockInsFunc == nil {
+		r1 = notImplemented("pos.Manager", "GetClockIns")
+		return
+	}
+	return m.GetClockInsFunc(ctx, filters)
+}
@@ -0,0 +1,412 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/prices"
+)
+
+var _ prices.Manager = (*PricesManagerMock)(nil)
+
+//PricesManagerMock mocks prices.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type PricesManagerMock struct {
+	CallRecorder
+	GetSupplierPriceListsFunc                   func(ctx context.Context, filters map[string]string) ([]prices.PriceList, error)
+	AddProductToSupplierPriceListFunc           func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error)
+	EditProductToSupplierPriceListFunc          func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error)
+	ChangeProductToSupplierPriceListBulkFunc    func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToSupplierPriceListResponseBulk, error)
+	GetSupplierPriceListsBulkFunc               func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetPriceListsResponseBulk, error)
+	GetProductsInPriceListFunc                  func(ctx context.Context, filters map[string]string) ([]prices.ProductsInPriceList, error)
+	GetProductsInPriceListWithStatusFunc        func(ctx context.Context, filters map[string]string) (prices.GetProductsInPriceListResponse, error)
+	GetProductsInPriceListBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetProductsInPriceListResponseBulk, error)
+	GetProductsInSupplierPriceListFunc          func(ctx context.Context, filters map[string]string) ([]prices.ProductsInSupplierPriceList, error)
+	GetProductsInSupplierPriceListBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.ProductsInSupplierPriceListResponseBulk, error)
+	DeleteProductsFromSupplierPriceListFunc     func(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromSupplierPriceListResult, error)
+	DeleteProductsFromSupplierPriceListBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromSupplierPriceListResponseBulk, error)
+	SaveSupplierPriceListFunc                   func(ctx context.Context, filters map[string]string) (*prices.SaveSupplierPriceListResult, error)
+	SaveSupplierPriceListBulkFunc               func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SaveSupplierPriceListResponseBulk, error)
+	GetPriceListsFunc                           func(ctx context.Context, filters map[string]string) (*prices.GetRegularPriceListResult, error)
+	GetPriceListsBulkFunc                       func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetRegularPriceListResponseBulk, error)
+	SavePriceListFunc                           func(ctx context.Context, filters map[string]string) (*prices.SavePriceListResult, error)
+	SavePriceListBulkFunc                       func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SavePriceListResponseBulk, error)
+	AddProductToPriceListFunc                   func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error)
+	EditProductToPriceListFunc                  func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error)
+	ChangeProductToPriceListBulkFunc            func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToPriceListResponseBulk, error)
+	DeleteProductsFromPriceListFunc             func(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromPriceListResult, error)
+	DeleteProductsFromPriceListBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromPriceListResponseBulk, error)
+	GetProductPricesFunc                        func(ctx context.Context, filters map[string]string) ([]prices.ProductPrice, error)
+	GetProductPricesInPriceListsFunc            func(ctx context.Context, filters map[string]string) ([]prices.ProductPricesInPriceLists, error)
+	GetProductsWithChangedPricesFunc            func(ctx context.Context, filters map[string]string) ([]int, error)
+}
+
+//NewPricesManagerMock creates a mock where all methods return ErrNotImplemented
+func NewPricesManagerMock() *PricesManagerMock {
+	return &PricesManagerMock{
+		GetSupplierPriceListsFunc: func(ctx context.Context, filters map[string]string) (r0 []prices.PriceList, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetSupplierPriceLists")
+			return
+		},
+		AddProductToSupplierPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToSupplierPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "AddProductToSupplierPriceList")
+			return
+		},
+		EditProductToSupplierPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToSupplierPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "EditProductToSupplierPriceList")
+			return
+		},
+		ChangeProductToSupplierPriceListBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.ChangeProductToSupplierPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "ChangeProductToSupplierPriceListBulk")
+			return
+		},
+		GetSupplierPriceListsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetPriceListsResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetSupplierPriceListsBulk")
+			return
+		},
+		GetProductsInPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 []prices.ProductsInPriceList, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsInPriceList")
+			return
+		},
+		GetProductsInPriceListWithStatusFunc: func(ctx context.Context, filters map[string]string) (r0 prices.GetProductsInPriceListResponse, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsInPriceListWithStatus")
+			return
+		},
+		GetProductsInPriceListBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetProductsInPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsInPriceListBulk")
+			return
+		},
+		GetProductsInSupplierPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 []prices.ProductsInSupplierPriceList, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsInSupplierPriceList")
+			return
+		},
+		GetProductsInSupplierPriceListBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.ProductsInSupplierPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsInSupplierPriceListBulk")
+			return
+		},
+		DeleteProductsFromSupplierPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.DeleteProductsFromSupplierPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "DeleteProductsFromSupplierPriceList")
+			return
+		},
+		DeleteProductsFromSupplierPriceListBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.DeleteProductsFromSupplierPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "DeleteProductsFromSupplierPriceListBulk")
+			return
+		},
+		SaveSupplierPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.SaveSupplierPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "SaveSupplierPriceList")
+			return
+		},
+		SaveSupplierPriceListBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.SaveSupplierPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "SaveSupplierPriceListBulk")
+			return
+		},
+		GetPriceListsFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.GetRegularPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetPriceLists")
+			return
+		},
+		GetPriceListsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetRegularPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetPriceListsBulk")
+			return
+		},
+		SavePriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.SavePriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "SavePriceList")
+			return
+		},
+		SavePriceListBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.SavePriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "SavePriceListBulk")
+			return
+		},
+		AddProductToPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "AddProductToPriceList")
+			return
+		},
+		EditProductToPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "EditProductToPriceList")
+			return
+		},
+		ChangeProductToPriceListBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.ChangeProductToPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "ChangeProductToPriceListBulk")
+			return
+		},
+		DeleteProductsFromPriceListFunc: func(ctx context.Context, filters map[string]string) (r0 *prices.DeleteProductsFromPriceListResult, r1 error) {
+			r1 = notImplemented("prices.Manager", "DeleteProductsFromPriceList")
+			return
+		},
+		DeleteProductsFromPriceListBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.DeleteProductsFromPriceListResponseBulk, r1 error) {
+			r1 = notImplemented("prices.Manager", "DeleteProductsFromPriceListBulk")
+			return
+		},
+		GetProductPricesFunc: func(ctx context.Context, filters map[string]string) (r0 []prices.ProductPrice, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductPrices")
+			return
+		},
+		GetProductPricesInPriceListsFunc: func(ctx context.Context, filters map[string]string) (r0 []prices.ProductPricesInPriceLists, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductPricesInPriceLists")
+			return
+		},
+		GetProductsWithChangedPricesFunc: func(ctx context.Context, filters map[string]string) (r0 []int, r1 error) {
+			r1 = notImplemented("prices.Manager", "GetProductsWithChangedPrices")
+			return
+		},
+	}
+}
+
+//GetSupplierPriceLists calls GetSupplierPriceListsFunc
+func (m *PricesManagerMock) GetSupplierPriceLists(ctx context.Context, filters map[string]string) (r0 []prices.PriceList, r1 error) {
+	m.record("GetSupplierPriceLists", ctx, filters)
+	if m.GetSupplierPriceListsFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetSupplierPriceLists")
+		return
+	}
+	return m.GetSupplierPriceListsFunc(ctx, filters)
+}
+
+//AddProductToSupplierPriceList calls AddProductToSupplierPriceListFunc
+func (m *PricesManagerMock) AddProductToSupplierPriceList(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToSupplierPriceListResult, r1 error) {
+	m.record("AddProductToSupplierPriceList", ctx, filters)
+	if m.AddProductToSupplierPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "AddProductToSupplierPriceList")
+		return
+	}
+	return m.AddProductToSupplierPriceListFunc(ctx, filters)
+}
+
+//EditProductToSupplierPriceList calls EditProductToSupplierPriceListFunc
+func (m *PricesManagerMock) EditProductToSupplierPriceList(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToSupplierPriceListResult, r1 error) {
+	m.record("EditProductToSupplierPriceList", ctx, filters)
+	if m.EditProductToSupplierPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "EditProductToSupplierPriceList")
+		return
+	}
+	return m.EditProductToSupplierPriceListFunc(ctx, filters)
+}
+
+//ChangeProductToSupplierPriceListBulk calls ChangeProductToSupplierPriceListBulkFunc
+func (m *PricesManagerMock) ChangeProductToSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.ChangeProductToSupplierPriceListResponseBulk, r1 error) {
+	m.record("ChangeProductToSupplierPriceListBulk", ctx, bulkRequest, baseFilters)
+	if m.ChangeProductToSupplierPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "ChangeProductToSupplierPriceListBulk")
+		return
+	}
+	return m.ChangeProductToSupplierPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//GetSupplierPriceListsBulk calls GetSupplierPriceListsBulkFunc
+func (m *PricesManagerMock) GetSupplierPriceListsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetPriceListsResponseBulk, r1 error) {
+	m.record("GetSupplierPriceListsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetSupplierPriceListsBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetSupplierPriceListsBulk")
+		return
+	}
+	return m.GetSupplierPriceListsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductsInPriceList calls GetProductsInPriceListFunc
+func (m *PricesManagerMock) GetProductsInPriceList(ctx context.Context, filters map[string]string) (r0 []prices.ProductsInPriceList, r1 error) {
+	m.record("GetProductsInPriceList", ctx, filters)
+	if m.GetProductsInPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsInPriceList")
+		return
+	}
+	return m.GetProductsInPriceListFunc(ctx, filters)
+}
+
+//GetProductsInPriceListWithStatus calls GetProductsInPriceListWithStatusFunc
+func (m *PricesManagerMock) GetProductsInPriceListWithStatus(ctx context.Context, filters map[string]string) (r0 prices.GetProductsInPriceListResponse, r1 error) {
+	m.record("GetProductsInPriceListWithStatus", ctx, filters)
+	if m.GetProductsInPriceListWithStatusFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsInPriceListWithStatus")
+		return
+	}
+	return m.GetProductsInPriceListWithStatusFunc(ctx, filters)
+}
+
+//GetProductsInPriceListBulk calls GetProductsInPriceListBulkFunc
+func (m *PricesManagerMock) GetProductsInPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetProductsInPriceListResponseBulk, r1 error) {
+	m.record("GetProductsInPriceListBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductsInPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsInPriceListBulk")
+		return
+	}
+	return m.GetProductsInPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductsInSupplierPriceList calls GetProductsInSupplierPriceListFunc
+func (m *PricesManagerMock) GetProductsInSupplierPriceList(ctx context.Context, filters map[string]string) (r0 []prices.ProductsInSupplierPriceList, r1 error) {
+	m.record("GetProductsInSupplierPriceList", ctx, filters)
+	if m.GetProductsInSupplierPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsInSupplierPriceList")
+		return
+	}
+	return m.GetProductsInSupplierPriceListFunc(ctx, filters)
+}
+
+//GetProductsInSupplierPriceListBulk calls GetProductsInSupplierPriceListBulkFunc
+func (m *PricesManagerMock) GetProductsInSupplierPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.ProductsInSupplierPriceListResponseBulk, r1 error) {
+	m.record("GetProductsInSupplierPriceListBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductsInSupplierPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsInSupplierPriceListBulk")
+		return
+	}
+	return m.GetProductsInSupplierPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteProductsFromSupplierPriceList calls DeleteProductsFromSupplierPriceListFunc
+func (m *PricesManagerMock) DeleteProductsFromSupplierPriceList(ctx context.Context, filters map[string]string) (r0 *prices.DeleteProductsFromSupplierPriceListResult, r1 error) {
+	m.record("DeleteProductsFromSupplierPriceList", ctx, filters)
+	if m.DeleteProductsFromSupplierPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "DeleteProductsFromSupplierPriceList")
+		return
+	}
+	return m.DeleteProductsFromSupplierPriceListFunc(ctx, filters)
+}
+
+//DeleteProductsFromSupplierPriceListBulk calls DeleteProductsFromSupplierPriceListBulkFunc
+func (m *PricesManagerMock) DeleteProductsFromSupplierPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.DeleteProductsFromSupplierPriceListResponseBulk, r1 error) {
+	m.record("DeleteProductsFromSupplierPriceListBulk", ctx, bulkFilters, baseFilters)
+	if m.DeleteProductsFromSupplierPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "DeleteProductsFromSupplierPriceListBulk")
+		return
+	}
+	return m.DeleteProductsFromSupplierPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveSupplierPriceList calls SaveSupplierPriceListFunc
+func (m *PricesManagerMock) SaveSupplierPriceList(ctx context.Context, filters map[string]string) (r0 *prices.SaveSupplierPriceListResult, r1 error) {
+	m.record("SaveSupplierPriceList", ctx, filters)
+	if m.SaveSupplierPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "SaveSupplierPriceList")
+		return
+	}
+	return m.SaveSupplierPriceListFunc(ctx, filters)
+}
+
+//SaveSupplierPriceListBulk calls SaveSupplierPriceListBulkFunc
+func (m *PricesManagerMock) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.SaveSupplierPriceListResponseBulk, r1 error) {
+	m.record("SaveSupplierPriceListBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveSupplierPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "SaveSupplierPriceListBulk")
+		return
+	}
+	return m.SaveSupplierPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//GetPriceLists calls GetPriceListsFunc
+func (m *PricesManagerMock) GetPriceLists(ctx context.Context, filters map[string]string) (r0 *prices.GetRegularPriceListResult, r1 error) {
+	m.record("GetPriceLists", ctx, filters)
+	if m.GetPriceListsFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetPriceLists")
+		return
+	}
+	return m.GetPriceListsFunc(ctx, filters)
+}
+
+//GetPriceListsBulk calls GetPriceListsBulkFunc
+func (m *PricesManagerMock) GetPriceListsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.GetRegularPriceListResponseBulk, r1 error) {
+	m.record("GetPriceListsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetPriceListsBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetPriceListsBulk")
+		return
+	}
+	return m.GetPriceListsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SavePriceList calls SavePriceListFunc
+func (m *PricesManagerMock) SavePriceList(ctx context.Context, filters map[string]string) (r0 *prices.SavePriceListResult, r1 error) {
+	m.record("SavePriceList", ctx, filters)
+	if m.SavePriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "SavePriceList")
+		return
+	}
+	return m.SavePriceListFunc(ctx, filters)
+}
+
+//SavePriceListBulk calls SavePriceListBulkFunc
+func (m *PricesManagerMock) SavePriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.SavePriceListResponseBulk, r1 error) {
+	m.record("SavePriceListBulk", ctx, bulkRequest, baseFilters)
+	if m.SavePriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "SavePriceListBulk")
+		return
+	}
+	return m.SavePriceListBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//AddProductToPriceList calls AddProductToPriceListFunc
+func (m *PricesManagerMock) AddProductToPriceList(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToPriceListResult, r1 error) {
+	m.record("AddProductToPriceList", ctx, filters)
+	if m.AddProductToPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "AddProductToPriceList")
+		return
+	}
+	return m.AddProductToPriceListFunc(ctx, filters)
+}
+
+//EditProductToPriceList calls EditProductToPriceListFunc
+func (m *PricesManagerMock) EditProductToPriceList(ctx context.Context, filters map[string]string) (r0 *prices.ChangeProductToPriceListResult, r1 error) {
+	m.record("EditProductToPriceList", ctx, filters)
+	if m.EditProductToPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "EditProductToPriceList")
+		return
+	}
+	return m.EditProductToPriceListFunc(ctx, filters)
+}
+
+//ChangeProductToPriceListBulk calls ChangeProductToPriceListBulkFunc
+func (m *PricesManagerMock) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 prices.ChangeProductToPriceListResponseBulk, r1 error) {
+	m.record("ChangeProductToPriceListBulk", ctx, bulkRequest, baseFilters)
+	if m.ChangeProductToPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "ChangeProductToPriceListBulk")
+		return
+	}
+	return m.ChangeProductToPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//DeleteProductsFromPriceList calls DeleteProductsFromPriceListFunc
+func (m *PricesManagerMock) DeleteProductsFromPriceList(ctx context.Context, filters map[string]string) (r0 *prices.DeleteProductsFromPriceListResult, r1 error) {
+	m.record("DeleteProductsFromPriceList", ctx, filters)
+	if m.DeleteProductsFromPriceListFunc == nil {
+		r1 = notImplemented("prices.Manager", "DeleteProductsFromPriceList")
+		return
+	}
+	return m.DeleteProductsFromPriceListFunc(ctx, filters)
+}
+
+//DeleteProductsFromPriceListBulk calls DeleteProductsFromPriceListBulkFunc
+func (m *PricesManagerMock) DeleteProductsFromPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 prices.DeleteProductsFromPriceListResponseBulk, r1 error) {
+	m.record("DeleteProductsFromPriceListBulk", ctx, bulkFilters, baseFilters)
+	if m.DeleteProductsFromPriceListBulkFunc == nil {
+		r1 = notImplemented("prices.Manager", "DeleteProductsFromPriceListBulk")
+		return
+	}
+	return m.DeleteProductsFromPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductPrices calls GetProductPricesFunc
+func (m *PricesManagerMock) GetProductPrices(ctx context.Context, filters map[string]string) (r0 []prices.ProductPrice, r1 error) {
+	m.record("GetProductPrices", ctx, filters)
+	if m.GetProductPricesFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductPrices")
+		return
+	}
+	return m.GetProductPricesFunc(ctx, filters)
+}
+
+//GetProductPricesInPriceLists calls GetProductPricesInPriceListsFunc
+func (m *PricesManagerMock) GetProductPricesInPriceLists(ctx context.Context, filters map[string]string) (r0 []prices.ProductPricesInPriceLists, r1 error) {
+	m.record("GetProductPricesInPriceLists", ctx, filters)
+	if m.GetProductPricesInPriceListsFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductPricesInPriceLists")
+		return
+	}
+	return m.GetProductPricesInPriceListsFunc(ctx, filters)
+}
+
+//GetProductsWithChangedPrices calls GetProductsWithChangedPricesFunc
+func (m *PricesManagerMock) GetProductsWithChangedPrices(ctx context.Context, filters map[string]string) (r0 []int, r1 error) {
+	m.record("GetProductsWithChangedPrices", ctx, filters)
+	if m.GetProductsWithChangedPricesFunc == nil {
+		r1 = notImplemented("prices.Manager", "GetProductsWithChangedPrices")
+		return
+	}
+	return m.GetProductsWithChangedPricesFunc(ctx, filters)
+}
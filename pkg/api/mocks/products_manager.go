@@ -0,0 +1,637 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+)
+
+var _ products.Manager = (*ProductsManagerMock)(nil)
+
+//ProductsManagerMock mocks products.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type ProductsManagerMock struct {
+	CallRecorder
+	GetProductsFunc                  func(ctx context.Context, filters map[string]string) ([]products.Product, error)
+	GetProductsCountFunc             func(ctx context.Context, filters map[string]string) (int, error)
+	GetProductsBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductsResponseBulk, error)
+	GetProductUnitsFunc              func(ctx context.Context, filters map[string]string) ([]products.ProductUnit, error)
+	GetProductCategoriesFunc         func(ctx context.Context, filters map[string]string) ([]products.ProductCategory, error)
+	GetProductCategoriesBulkFunc     func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductCategoryResponseBulk, error)
+	GetProductBrandsFunc             func(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error)
+	GetBrandsFunc                    func(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error)
+	GetProductPriorityGroupsFunc     func(ctx context.Context, filters map[string]string) (products.GetProductPriorityGroups, error)
+	GetProductPriorityGroupBulkFunc  func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductPriorityGroupResponseBulk, error)
+	GetProductGroupsFunc             func(ctx context.Context, filters map[string]string) ([]products.ProductGroup, error)
+	GetProductGroupsBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductGroupResponseBulk, error)
+	GetProductStockFunc              func(ctx context.Context, filters map[string]string) ([]products.GetProductStock, error)
+	GetProductStockFileFunc          func(ctx context.Context, filters map[string]string) ([]products.GetProductStockFile, error)
+	GetProductStockFileBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockFileResponseBulk, error)
+	GetProductStockBulkFunc          func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockResponseBulk, error)
+	SaveProductFunc                  func(ctx context.Context, filters map[string]string) (products.SaveProductResult, error)
+	SaveProductBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductResponseBulk, error)
+	DeleteProductFunc                func(ctx context.Context, filters map[string]string) error
+	DeleteProductBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductResponseBulk, error)
+	SaveAssortmentFunc               func(ctx context.Context, filters map[string]string) (products.SaveAssortmentResult, error)
+	SaveAssortmentBulkFunc           func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveAssortmentResponseBulk, error)
+	AddAssortmentProductsFunc        func(ctx context.Context, filters map[string]string) (products.AddAssortmentProductsResult, error)
+	AddAssortmentProductsBulkFunc    func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.AddAssortmentProductsResponseBulk, error)
+	EditAssortmentProductsFunc       func(ctx context.Context, filters map[string]string) (products.EditAssortmentProductsResult, error)
+	EditAssortmentProductsBulkFunc   func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.EditAssortmentProductsResponseBulk, error)
+	RemoveAssortmentProductsFunc     func(ctx context.Context, filters map[string]string) (products.RemoveAssortmentProductResult, error)
+	RemoveAssortmentProductsBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.RemoveAssortmentProductResponseBulk, error)
+	SaveProductCategoryFunc          func(ctx context.Context, filters map[string]string) (products.SaveProductCategoryResult, error)
+	SaveProductCategoryBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductCategoryResponseBulk, error)
+	SaveBrandFunc                    func(ctx context.Context, filters map[string]string) (products.SaveBrandResult, error)
+	SaveBrandBulkFunc                func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveBrandResponseBulk, error)
+	SaveProductPriorityGroupFunc     func(ctx context.Context, filters map[string]string) (products.SaveProductPriorityGroupResult, error)
+	SaveProductPriorityGroupBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductPriorityGroupResponseBulk, error)
+	SaveProductGroupFunc             func(ctx context.Context, filters map[string]string) (products.SaveProductGroupResult, error)
+	SaveProductGroupBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductGroupResponseBulk, error)
+	DeleteProductGroupFunc           func(ctx context.Context, filters map[string]string) error
+	DeleteProductGroupBulkFunc       func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductGroupResponseBulk, error)
+	GetProductFilesFunc              func(ctx context.Context, filters map[string]string) (products.GetProductFilesResponse, error)
+	GetProductPicturesFunc           func(ctx context.Context, filters map[string]string) ([]products.Image, error)
+	GetProductPicturesBulkFunc       func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductPicturesResponseBulk, error)
+}
+
+//NewProductsManagerMock creates a mock where all methods return ErrNotImplemented
+func NewProductsManagerMock() *ProductsManagerMock {
+	return &ProductsManagerMock{
+		GetProductsFunc: func(ctx context.Context, filters map[string]string) (r0 []products.Product, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProducts")
+			return
+		},
+		GetProductsCountFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductsCount")
+			return
+		},
+		GetProductsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductsResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductsBulk")
+			return
+		},
+		GetProductUnitsFunc: func(ctx context.Context, filters map[string]string) (r0 []products.ProductUnit, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductUnits")
+			return
+		},
+		GetProductCategoriesFunc: func(ctx context.Context, filters map[string]string) (r0 []products.ProductCategory, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductCategories")
+			return
+		},
+		GetProductCategoriesBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductCategoryResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductCategoriesBulk")
+			return
+		},
+		GetProductBrandsFunc: func(ctx context.Context, filters map[string]string) (r0 []products.ProductBrand, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductBrands")
+			return
+		},
+		GetBrandsFunc: func(ctx context.Context, filters map[string]string) (r0 []products.ProductBrand, r1 error) {
+			r1 = notImplemented("products.Manager", "GetBrands")
+			return
+		},
+		GetProductPriorityGroupsFunc: func(ctx context.Context, filters map[string]string) (r0 products.GetProductPriorityGroups, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductPriorityGroups")
+			return
+		},
+		GetProductPriorityGroupBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductPriorityGroupResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductPriorityGroupBulk")
+			return
+		},
+		GetProductGroupsFunc: func(ctx context.Context, filters map[string]string) (r0 []products.ProductGroup, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductGroups")
+			return
+		},
+		GetProductGroupsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductGroupResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductGroupsBulk")
+			return
+		},
+		GetProductStockFunc: func(ctx context.Context, filters map[string]string) (r0 []products.GetProductStock, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductStock")
+			return
+		},
+		GetProductStockFileFunc: func(ctx context.Context, filters map[string]string) (r0 []products.GetProductStockFile, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductStockFile")
+			return
+		},
+		GetProductStockFileBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductStockFileResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductStockFileBulk")
+			return
+		},
+		GetProductStockBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductStockResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductStockBulk")
+			return
+		},
+		SaveProductFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveProductResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProduct")
+			return
+		},
+		SaveProductBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductBulk")
+			return
+		},
+		DeleteProductFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("products.Manager", "DeleteProduct")
+			return
+		},
+		DeleteProductBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.DeleteProductResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "DeleteProductBulk")
+			return
+		},
+		SaveAssortmentFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveAssortmentResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveAssortment")
+			return
+		},
+		SaveAssortmentBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveAssortmentResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveAssortmentBulk")
+			return
+		},
+		AddAssortmentProductsFunc: func(ctx context.Context, filters map[string]string) (r0 products.AddAssortmentProductsResult, r1 error) {
+			r1 = notImplemented("products.Manager", "AddAssortmentProducts")
+			return
+		},
+		AddAssortmentProductsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.AddAssortmentProductsResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "AddAssortmentProductsBulk")
+			return
+		},
+		EditAssortmentProductsFunc: func(ctx context.Context, filters map[string]string) (r0 products.EditAssortmentProductsResult, r1 error) {
+			r1 = notImplemented("products.Manager", "EditAssortmentProducts")
+			return
+		},
+		EditAssortmentProductsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.EditAssortmentProductsResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "EditAssortmentProductsBulk")
+			return
+		},
+		RemoveAssortmentProductsFunc: func(ctx context.Context, filters map[string]string) (r0 products.RemoveAssortmentProductResult, r1 error) {
+			r1 = notImplemented("products.Manager", "RemoveAssortmentProducts")
+			return
+		},
+		RemoveAssortmentProductsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.RemoveAssortmentProductResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "RemoveAssortmentProductsBulk")
+			return
+		},
+		SaveProductCategoryFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveProductCategoryResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductCategory")
+			return
+		},
+		SaveProductCategoryBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductCategoryResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductCategoryBulk")
+			return
+		},
+		SaveBrandFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveBrandResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveBrand")
+			return
+		},
+		SaveBrandBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveBrandResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveBrandBulk")
+			return
+		},
+		SaveProductPriorityGroupFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveProductPriorityGroupResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductPriorityGroup")
+			return
+		},
+		SaveProductPriorityGroupBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductPriorityGroupResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductPriorityGroupBulk")
+			return
+		},
+		SaveProductGroupFunc: func(ctx context.Context, filters map[string]string) (r0 products.SaveProductGroupResult, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductGroup")
+			return
+		},
+		SaveProductGroupBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductGroupResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "SaveProductGroupBulk")
+			return
+		},
+		DeleteProductGroupFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("products.Manager", "DeleteProductGroup")
+			return
+		},
+		DeleteProductGroupBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.DeleteProductGroupResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "DeleteProductGroupBulk")
+			return
+		},
+		GetProductFilesFunc: func(ctx context.Context, filters map[string]string) (r0 products.GetProductFilesResponse, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductFiles")
+			return
+		},
+		GetProductPicturesFunc: func(ctx context.Context, filters map[string]string) (r0 []products.Image, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductPictures")
+			return
+		},
+		GetProductPicturesBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductPicturesResponseBulk, r1 error) {
+			r1 = notImplemented("products.Manager", "GetProductPicturesBulk")
+			return
+		},
+	}
+}
+
+//GetProducts calls GetProductsFunc
+func (m *ProductsManagerMock) GetProducts(ctx context.Context, filters map[string]string) (r0 []products.Product, r1 error) {
+	m.record("GetProducts", ctx, filters)
+	if m.GetProductsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProducts")
+		return
+	}
+	return m.GetProductsFunc(ctx, filters)
+}
+
+//GetProductsCount calls GetProductsCountFunc
+func (m *ProductsManagerMock) GetProductsCount(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("GetProductsCount", ctx, filters)
+	if m.GetProductsCountFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductsCount")
+		return
+	}
+	return m.GetProductsCountFunc(ctx, filters)
+}
+
+//GetProductsBulk calls GetProductsBulkFunc
+func (m *ProductsManagerMock) GetProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductsResponseBulk, r1 error) {
+	m.record("GetProductsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductsBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductsBulk")
+		return
+	}
+	return m.GetProductsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductUnits calls GetProductUnitsFunc
+func (m *ProductsManagerMock) GetProductUnits(ctx context.Context, filters map[string]string) (r0 []products.ProductUnit, r1 error) {
+	m.record("GetProductUnits", ctx, filters)
+	if m.GetProductUnitsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductUnits")
+		return
+	}
+	return m.GetProductUnitsFunc(ctx, filters)
+}
+
+//GetProductCategories calls GetProductCategoriesFunc
+func (m *ProductsManagerMock) GetProductCategories(ctx context.Context, filters map[string]string) (r0 []products.ProductCategory, r1 error) {
+	m.record("GetProductCategories", ctx, filters)
+	if m.GetProductCategoriesFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductCategories")
+		return
+	}
+	return m.GetProductCategoriesFunc(ctx, filters)
+}
+
+//GetProductCategoriesBulk calls GetProductCategoriesBulkFunc
+func (m *ProductsManagerMock) GetProductCategoriesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductCategoryResponseBulk, r1 error) {
+	m.record("GetProductCategoriesBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductCategoriesBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductCategoriesBulk")
+		return
+	}
+	return m.GetProductCategoriesBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductBrands calls GetProductBrandsFunc
+func (m *ProductsManagerMock) GetProductBrands(ctx context.Context, filters map[string]string) (r0 []products.ProductBrand, r1 error) {
+	m.record("GetProductBrands", ctx, filters)
+	if m.GetProductBrandsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductBrands")
+		return
+	}
+	return m.GetProductBrandsFunc(ctx, filters)
+}
+
+//GetBrands calls GetBrandsFunc
+func (m *ProductsManagerMock) GetBrands(ctx context.Context, filters map[string]string) (r0 []products.ProductBrand, r1 error) {
+	m.record("GetBrands", ctx, filters)
+	if m.GetBrandsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetBrands")
+		return
+	}
+	return m.GetBrandsFunc(ctx, filters)
+}
+
+//GetProductPriorityGroups calls GetProductPriorityGroupsFunc
+func (m *ProductsManagerMock) GetProductPriorityGroups(ctx context.Context, filters map[string]string) (r0 products.GetProductPriorityGroups, r1 error) {
+	m.record("GetProductPriorityGroups", ctx, filters)
+	if m.GetProductPriorityGroupsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductPriorityGroups")
+		return
+	}
+	return m.GetProductPriorityGroupsFunc(ctx, filters)
+}
+
+//GetProductPriorityGroupBulk calls GetProductPriorityGroupBulkFunc
+func (m *ProductsManagerMock) GetProductPriorityGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductPriorityGroupResponseBulk, r1 error) {
+	m.record("GetProductPriorityGroupBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductPriorityGroupBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductPriorityGroupBulk")
+		return
+	}
+	return m.GetProductPriorityGroupBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductGroups calls GetProductGroupsFunc
+func (m *ProductsManagerMock) GetProductGroups(ctx context.Context, filters map[string]string) (r0 []products.ProductGroup, r1 error) {
+	m.record("GetProductGroups", ctx, filters)
+	if m.GetProductGroupsFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductGroups")
+		return
+	}
+	return m.GetProductGroupsFunc(ctx, filters)
+}
+
+//GetProductGroupsBulk calls GetProductGroupsBulkFunc
+func (m *ProductsManagerMock) GetProductGroupsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductGroupResponseBulk, r1 error) {
+	m.record("GetProductGroupsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductGroupsBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductGroupsBulk")
+		return
+	}
+	return m.GetProductGroupsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductStock calls GetProductStockFunc
+func (m *ProductsManagerMock) GetProductStock(ctx context.Context, filters map[string]string) (r0 []products.GetProductStock, r1 error) {
+	m.record("GetProductStock", ctx, filters)
+	if m.GetProductStockFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductStock")
+		return
+	}
+	return m.GetProductStockFunc(ctx, filters)
+}
+
+//GetProductStockFile calls GetProductStockFileFunc
+func (m *ProductsManagerMock) GetProductStockFile(ctx context.Context, filters map[string]string) (r0 []products.GetProductStockFile, r1 error) {
+	m.record("GetProductStockFile", ctx, filters)
+	if m.GetProductStockFileFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductStockFile")
+		return
+	}
+	return m.GetProductStockFileFunc(ctx, filters)
+}
+
+//GetProductStockFileBulk calls GetProductStockFileBulkFunc
+func (m *ProductsManagerMock) GetProductStockFileBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductStockFileResponseBulk, r1 error) {
+	m.record("GetProductStockFileBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductStockFileBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductStockFileBulk")
+		return
+	}
+	return m.GetProductStockFileBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductStockBulk calls GetProductStockBulkFunc
+func (m *ProductsManagerMock) GetProductStockBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductStockResponseBulk, r1 error) {
+	m.record("GetProductStockBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductStockBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductStockBulk")
+		return
+	}
+	return m.GetProductStockBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveProduct calls SaveProductFunc
+func (m *ProductsManagerMock) SaveProduct(ctx context.Context, filters map[string]string) (r0 products.SaveProductResult, r1 error) {
+	m.record("SaveProduct", ctx, filters)
+	if m.SaveProductFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProduct")
+		return
+	}
+	return m.SaveProductFunc(ctx, filters)
+}
+
+//SaveProductBulk calls SaveProductBulkFunc
+func (m *ProductsManagerMock) SaveProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductResponseBulk, r1 error) {
+	m.record("SaveProductBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveProductBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductBulk")
+		return
+	}
+	return m.SaveProductBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteProduct calls DeleteProductFunc
+func (m *ProductsManagerMock) DeleteProduct(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteProduct", ctx, filters)
+	if m.DeleteProductFunc == nil {
+		r0 = notImplemented("products.Manager", "DeleteProduct")
+		return
+	}
+	return m.DeleteProductFunc(ctx, filters)
+}
+
+//DeleteProductBulk calls DeleteProductBulkFunc
+func (m *ProductsManagerMock) DeleteProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.DeleteProductResponseBulk, r1 error) {
+	m.record("DeleteProductBulk", ctx, bulkFilters, baseFilters)
+	if m.DeleteProductBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "DeleteProductBulk")
+		return
+	}
+	return m.DeleteProductBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveAssortment calls SaveAssortmentFunc
+func (m *ProductsManagerMock) SaveAssortment(ctx context.Context, filters map[string]string) (r0 products.SaveAssortmentResult, r1 error) {
+	m.record("SaveAssortment", ctx, filters)
+	if m.SaveAssortmentFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveAssortment")
+		return
+	}
+	return m.SaveAssortmentFunc(ctx, filters)
+}
+
+//SaveAssortmentBulk calls SaveAssortmentBulkFunc
+func (m *ProductsManagerMock) SaveAssortmentBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveAssortmentResponseBulk, r1 error) {
+	m.record("SaveAssortmentBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveAssortmentBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveAssortmentBulk")
+		return
+	}
+	return m.SaveAssortmentBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//AddAssortmentProducts calls AddAssortmentProductsFunc
+func (m *ProductsManagerMock) AddAssortmentProducts(ctx context.Context, filters map[string]string) (r0 products.AddAssortmentProductsResult, r1 error) {
+	m.record("AddAssortmentProducts", ctx, filters)
+	if m.AddAssortmentProductsFunc == nil {
+		r1 = notImplemented("products.Manager", "AddAssortmentProducts")
+		return
+	}
+	return m.AddAssortmentProductsFunc(ctx, filters)
+}
+
+//AddAssortmentProductsBulk calls AddAssortmentProductsBulkFunc
+func (m *ProductsManagerMock) AddAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.AddAssortmentProductsResponseBulk, r1 error) {
+	m.record("AddAssortmentProductsBulk", ctx, bulkFilters, baseFilters)
+	if m.AddAssortmentProductsBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "AddAssortmentProductsBulk")
+		return
+	}
+	return m.AddAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//EditAssortmentProducts calls EditAssortmentProductsFunc
+func (m *ProductsManagerMock) EditAssortmentProducts(ctx context.Context, filters map[string]string) (r0 products.EditAssortmentProductsResult, r1 error) {
+	m.record("EditAssortmentProducts", ctx, filters)
+	if m.EditAssortmentProductsFunc == nil {
+		r1 = notImplemented("products.Manager", "EditAssortmentProducts")
+		return
+	}
+	return m.EditAssortmentProductsFunc(ctx, filters)
+}
+
+//EditAssortmentProductsBulk calls EditAssortmentProductsBulkFunc
+func (m *ProductsManagerMock) EditAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.EditAssortmentProductsResponseBulk, r1 error) {
+	m.record("EditAssortmentProductsBulk", ctx, bulkFilters, baseFilters)
+	if m.EditAssortmentProductsBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "EditAssortmentProductsBulk")
+		return
+	}
+	return m.EditAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//RemoveAssortmentProducts calls RemoveAssortmentProductsFunc
+func (m *ProductsManagerMock) RemoveAssortmentProducts(ctx context.Context, filters map[string]string) (r0 products.RemoveAssortmentProductResult, r1 error) {
+	m.record("RemoveAssortmentProducts", ctx, filters)
+	if m.RemoveAssortmentProductsFunc == nil {
+		r1 = notImplemented("products.Manager", "RemoveAssortmentProducts")
+		return
+	}
+	return m.RemoveAssortmentProductsFunc(ctx, filters)
+}
+
+//RemoveAssortmentProductsBulk calls RemoveAssortmentProductsBulkFunc
+func (m *ProductsManagerMock) RemoveAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.RemoveAssortmentProductResponseBulk, r1 error) {
+	m.record("RemoveAssortmentProductsBulk", ctx, bulkFilters, baseFilters)
+	if m.RemoveAssortmentProductsBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "RemoveAssortmentProductsBulk")
+		return
+	}
+	return m.RemoveAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveProductCategory calls SaveProductCategoryFunc
+func (m *ProductsManagerMock) SaveProductCategory(ctx context.Context, filters map[string]string) (r0 products.SaveProductCategoryResult, r1 error) {
+	m.record("SaveProductCategory", ctx, filters)
+	if m.SaveProductCategoryFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductCategory")
+		return
+	}
+	return m.SaveProductCategoryFunc(ctx, filters)
+}
+
+//SaveProductCategoryBulk calls SaveProductCategoryBulkFunc
+func (m *ProductsManagerMock) SaveProductCategoryBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductCategoryResponseBulk, r1 error) {
+	m.record("SaveProductCategoryBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveProductCategoryBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductCategoryBulk")
+		return
+	}
+	return m.SaveProductCategoryBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveBrand calls SaveBrandFunc
+func (m *ProductsManagerMock) SaveBrand(ctx context.Context, filters map[string]string) (r0 products.SaveBrandResult, r1 error) {
+	m.record("SaveBrand", ctx, filters)
+	if m.SaveBrandFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveBrand")
+		return
+	}
+	return m.SaveBrandFunc(ctx, filters)
+}
+
+//SaveBrandBulk calls SaveBrandBulkFunc
+func (m *ProductsManagerMock) SaveBrandBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveBrandResponseBulk, r1 error) {
+	m.record("SaveBrandBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveBrandBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveBrandBulk")
+		return
+	}
+	return m.SaveBrandBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveProductPriorityGroup calls SaveProductPriorityGroupFunc
+func (m *ProductsManagerMock) SaveProductPriorityGroup(ctx context.Context, filters map[string]string) (r0 products.SaveProductPriorityGroupResult, r1 error) {
+	m.record("SaveProductPriorityGroup", ctx, filters)
+	if m.SaveProductPriorityGroupFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductPriorityGroup")
+		return
+	}
+	return m.SaveProductPriorityGroupFunc(ctx, filters)
+}
+
+//SaveProductPriorityGroupBulk calls SaveProductPriorityGroupBulkFunc
+func (m *ProductsManagerMock) SaveProductPriorityGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductPriorityGroupResponseBulk, r1 error) {
+	m.record("SaveProductPriorityGroupBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveProductPriorityGroupBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductPriorityGroupBulk")
+		return
+	}
+	return m.SaveProductPriorityGroupBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveProductGroup calls SaveProductGroupFunc
+func (m *ProductsManagerMock) SaveProductGroup(ctx context.Context, filters map[string]string) (r0 products.SaveProductGroupResult, r1 error) {
+	m.record("SaveProductGroup", ctx, filters)
+	if m.SaveProductGroupFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductGroup")
+		return
+	}
+	return m.SaveProductGroupFunc(ctx, filters)
+}
+
+//SaveProductGroupBulk calls SaveProductGroupBulkFunc
+func (m *ProductsManagerMock) SaveProductGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.SaveProductGroupResponseBulk, r1 error) {
+	m.record("SaveProductGroupBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveProductGroupBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "SaveProductGroupBulk")
+		return
+	}
+	return m.SaveProductGroupBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteProductGroup calls DeleteProductGroupFunc
+func (m *ProductsManagerMock) DeleteProductGroup(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteProductGroup", ctx, filters)
+	if m.DeleteProductGroupFunc == nil {
+		r0 = notImplemented("products.Manager", "DeleteProductGroup")
+		return
+	}
+	return m.DeleteProductGroupFunc(ctx, filters)
+}
+
+//DeleteProductGroupBulk calls DeleteProductGroupBulkFunc
+func (m *ProductsManagerMock) DeleteProductGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.DeleteProductGroupResponseBulk, r1 error) {
+	m.record("DeleteProductGroupBulk", ctx, bulkFilters, baseFilters)
+	if m.DeleteProductGroupBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "DeleteProductGroupBulk")
+		return
+	}
+	return m.DeleteProductGroupBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetProductFiles calls GetProductFilesFunc
+func (m *ProductsManagerMock) GetProductFiles(ctx context.Context, filters map[string]string) (r0 products.GetProductFilesResponse, r1 error) {
+	m.record("GetProductFiles", ctx, filters)
+	if m.GetProductFilesFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductFiles")
+		return
+	}
+	return m.GetProductFilesFunc(ctx, filters)
+}
+
+//GetProductPictures calls GetProductPicturesFunc
+func (m *ProductsManagerMock) GetProductPictures(ctx context.Context, filters map[string]string) (r0 []products.Image, r1 error) {
+	m.record("GetProductPictures", ctx, filters)
+	if m.GetProductPicturesFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductPictures")
+		return
+	}
+	return m.GetProductPicturesFunc(ctx, filters)
+}
+
+//GetProductPicturesBulk calls GetProductPicturesBulkFunc
+func (m *ProductsManagerMock) GetProductPicturesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 products.GetProductPicturesResponseBulk, r1 error) {
+	m.record("GetProductPicturesBulk", ctx, bulkFilters, baseFilters)
+	if m.GetProductPicturesBulkFunc == nil {
+		r1 = notImplemented("products.Manager", "GetProductPicturesBulk")
+		return
+	}
+	return m.GetProductPicturesBulkFunc(ctx, bulkFilters, baseFilters)
+}
@@ -0,0 +1,68 @@
+package mocks
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+//ErrNotImplemented is returned by the methods of the mocks which have no function set
+var ErrNotImplemented = errors.New("mock method is not implemented")
+
+func notImplemented(iface, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrNotImplemented, iface, method)
+}
+
+//Call is a recorded call of a mock method
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+//CallRecorder records the calls of the mock methods, it's safe for concurrent use
+type CallRecorder struct {
+	lock  sync.Mutex
+	calls []Call
+}
+
+func (r *CallRecorder) record(method string, args ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+//Calls gives all recorded calls in the calling order
+func (r *CallRecorder) Calls() []Call {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	res := make([]Call, len(r.calls))
+	copy(res, r.calls)
+	return res
+}
+
+//CallsOf gives the recorded calls of the method in the calling order
+func (r *CallRecorder) CallsOf(method string) []Call {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var res []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			res = append(res, call)
+		}
+	}
+	return res
+}
+
+//CallsCount gives how many times the method was called
+func (r *CallRecorder) CallsCount(method string) int {
+	return len(r.CallsOf(method))
+}
+
+//Reset removes the recorded calls
+func (r *CallRecorder) Reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.calls = nil
+}
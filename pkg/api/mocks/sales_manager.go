@@ -0,0 +1,442 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+)
+
+var _ sales.Manager = (*SalesManagerMock)(nil)
+
+//SalesManagerMock mocks sales.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type SalesManagerMock struct {
+	CallRecorder
+	GetProjectsFunc                               func(ctx context.Context, filters map[string]string) ([]sales.Project, error)
+	GetProjectStatusFunc                          func(ctx context.Context, filters map[string]string) ([]sales.ProjectStatus, error)
+	SaveSalesDocumentFunc                         func(ctx context.Context, filters map[string]string) (sales.SaleDocImportReports, error)
+	SaveSalesDocumentBulkFunc                     func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.SaveSalesDocumentResponseBulk, error)
+	GetSalesDocumentsFunc                         func(ctx context.Context, filters map[string]string) ([]sales.SaleDocument, error)
+	GetSalesDocumentsWithStatusFunc               func(ctx context.Context, filters map[string]string) (*sales.GetSalesDocumentResponse, error)
+	GetSalesDocumentsBulkFunc                     func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.GetSaleDocumentResponseBulk, error)
+	DeleteDocumentFunc                            func(ctx context.Context, filters map[string]string) error
+	SavePurchaseDocumentFunc                      func(ctx context.Context, filters map[string]string) (sales.PurchaseDocImportReports, error)
+	SavePurchaseDocumentBulkFunc                  func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.SavePurchaseDocumentResponseBulk, error)
+	DeleteDocumentsBulkFunc                       func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.DeleteResponseBulk, error)
+	GetVatRatesFunc                               func(ctx context.Context, filters map[string]string) (sales.VatRates, error)
+	GetVatRatesBulkFunc                           func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.GetVatRatesResponseBulk, error)
+	SaveVatRateFunc                               func(ctx context.Context, filters map[string]string) (*sales.SaveVatRateResult, error)
+	SaveVatRateBulkFunc                           func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (sales.SaveVatRateResponseBulk, error)
+	SaveVatRateComponentFunc                      func(ctx context.Context, filters map[string]string) (*sales.SaveVatRateComponentResult, error)
+	SaveVatRateComponentBulkFunc                  func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (sales.SaveVatRateComponentResponseBulk, error)
+	SaveAssignmentFunc                            func(ctx context.Context, filters map[string]string) (int64, error)
+	GetSalesReportFunc                            func(ctx context.Context, filters map[string]string) (*sales.GetSalesReport, error)
+	GetCouponsFunc                                func(ctx context.Context, filters map[string]string) (*sales.GetCouponsResponse, error)
+	SavePaymentFunc                               func(ctx context.Context, filters map[string]string) (int64, error)
+	SavePaymentsBulkFunc                          func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.SavePaymentsResponseBulk, error)
+	GetPaymentsFunc                               func(ctx context.Context, filters map[string]string) ([]sales.PaymentInfo, error)
+	GetPaymentsBulkFunc                           func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.GetPaymentsResponseBulk, error)
+	DeletePaymentFunc                             func(ctx context.Context, filters map[string]string) error
+	DeletePaymentsBulkFunc                        func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (sales.DeleteResponseBulk, error)
+	CalculateShoppingCartFunc                     func(ctx context.Context, filters map[string]string) (*sales.ShoppingCartTotals, error)
+	CalculateShoppingCartWithFullRowsResponseFunc func(ctx context.Context, filters map[string]string) (*sales.ShoppingCartTotalsWithFullRows, error)
+}
+
+//NewSalesManagerMock creates a mock where all methods return ErrNotImplemented
+func NewSalesManagerMock() *SalesManagerMock {
+	return &SalesManagerMock{
+		GetProjectsFunc: func(ctx context.Context, filters map[string]string) (r0 []sales.Project, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetProjects")
+			return
+		},
+		GetProjectStatusFunc: func(ctx context.Context, filters map[string]string) (r0 []sales.ProjectStatus, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetProjectStatus")
+			return
+		},
+		SaveSalesDocumentFunc: func(ctx context.Context, filters map[string]string) (r0 sales.SaleDocImportReports, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveSalesDocument")
+			return
+		},
+		SaveSalesDocumentBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveSalesDocumentResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveSalesDocumentBulk")
+			return
+		},
+		GetSalesDocumentsFunc: func(ctx context.Context, filters map[string]string) (r0 []sales.SaleDocument, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetSalesDocuments")
+			return
+		},
+		GetSalesDocumentsWithStatusFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.GetSalesDocumentResponse, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetSalesDocumentsWithStatus")
+			return
+		},
+		GetSalesDocumentsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetSaleDocumentResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetSalesDocumentsBulk")
+			return
+		},
+		DeleteDocumentFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("sales.Manager", "DeleteDocument")
+			return
+		},
+		SavePurchaseDocumentFunc: func(ctx context.Context, filters map[string]string) (r0 sales.PurchaseDocImportReports, r1 error) {
+			r1 = notImplemented("sales.Manager", "SavePurchaseDocument")
+			return
+		},
+		SavePurchaseDocumentBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SavePurchaseDocumentResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "SavePurchaseDocumentBulk")
+			return
+		},
+		DeleteDocumentsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.DeleteResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "DeleteDocumentsBulk")
+			return
+		},
+		GetVatRatesFunc: func(ctx context.Context, filters map[string]string) (r0 sales.VatRates, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetVatRates")
+			return
+		},
+		GetVatRatesBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetVatRatesResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetVatRatesBulk")
+			return
+		},
+		SaveVatRateFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.SaveVatRateResult, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveVatRate")
+			return
+		},
+		SaveVatRateBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveVatRateResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveVatRateBulk")
+			return
+		},
+		SaveVatRateComponentFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.SaveVatRateComponentResult, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveVatRateComponent")
+			return
+		},
+		SaveVatRateComponentBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveVatRateComponentResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveVatRateComponentBulk")
+			return
+		},
+		SaveAssignmentFunc: func(ctx context.Context, filters map[string]string) (r0 int64, r1 error) {
+			r1 = notImplemented("sales.Manager", "SaveAssignment")
+			return
+		},
+		GetSalesReportFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.GetSalesReport, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetSalesReport")
+			return
+		},
+		GetCouponsFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.GetCouponsResponse, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetCoupons")
+			return
+		},
+		SavePaymentFunc: func(ctx context.Context, filters map[string]string) (r0 int64, r1 error) {
+			r1 = notImplemented("sales.Manager", "SavePayment")
+			return
+		},
+		SavePaymentsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SavePaymentsResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "SavePaymentsBulk")
+			return
+		},
+		GetPaymentsFunc: func(ctx context.Context, filters map[string]string) (r0 []sales.PaymentInfo, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetPayments")
+			return
+		},
+		GetPaymentsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetPaymentsResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "GetPaymentsBulk")
+			return
+		},
+		DeletePaymentFunc: func(ctx context.Context, filters map[string]string) (r0 error) {
+			r0 = notImplemented("sales.Manager", "DeletePayment")
+			return
+		},
+		DeletePaymentsBulkFunc: func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.DeleteResponseBulk, r1 error) {
+			r1 = notImplemented("sales.Manager", "DeletePaymentsBulk")
+			return
+		},
+		CalculateShoppingCartFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.ShoppingCartTotals, r1 error) {
+			r1 = notImplemented("sales.Manager", "CalculateShoppingCart")
+			return
+		},
+		CalculateShoppingCartWithFullRowsResponseFunc: func(ctx context.Context, filters map[string]string) (r0 *sales.ShoppingCartTotalsWithFullRows, r1 error) {
+			r1 = notImplemented("sales.Manager", "CalculateShoppingCartWithFullRowsResponse")
+			return
+		},
+	}
+}
+
+//GetProjects calls GetProjectsFunc
+func (m *SalesManagerMock) GetProjects(ctx context.Context, filters map[string]string) (r0 []sales.Project, r1 error) {
+	m.record("GetProjects", ctx, filters)
+	if m.GetProjectsFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetProjects")
+		return
+	}
+	return m.GetProjectsFunc(ctx, filters)
+}
+
+//GetProjectStatus calls GetProjectStatusFunc
+func (m *SalesManagerMock) GetProjectStatus(ctx context.Context, filters map[string]string) (r0 []sales.ProjectStatus, r1 error) {
+	m.record("GetProjectStatus", ctx, filters)
+	if m.GetProjectStatusFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetProjectStatus")
+		return
+	}
+	return m.GetProjectStatusFunc(ctx, filters)
+}
+
+//SaveSalesDocument calls SaveSalesDocumentFunc
+func (m *SalesManagerMock) SaveSalesDocument(ctx context.Context, filters map[string]string) (r0 sales.SaleDocImportReports, r1 error) {
+	m.record("SaveSalesDocument", ctx, filters)
+	if m.SaveSalesDocumentFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveSalesDocument")
+		return
+	}
+	return m.SaveSalesDocumentFunc(ctx, filters)
+}
+
+//SaveSalesDocumentBulk calls SaveSalesDocumentBulkFunc
+func (m *SalesManagerMock) SaveSalesDocumentBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveSalesDocumentResponseBulk, r1 error) {
+	m.record("SaveSalesDocumentBulk", ctx, bulkFilters, baseFilters)
+	if m.SaveSalesDocumentBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveSalesDocumentBulk")
+		return
+	}
+	return m.SaveSalesDocumentBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetSalesDocuments calls GetSalesDocumentsFunc
+func (m *SalesManagerMock) GetSalesDocuments(ctx context.Context, filters map[string]string) (r0 []sales.SaleDocument, r1 error) {
+	m.record("GetSalesDocuments", ctx, filters)
+	if m.GetSalesDocumentsFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetSalesDocuments")
+		return
+	}
+	return m.GetSalesDocumentsFunc(ctx, filters)
+}
+
+//GetSalesDocumentsWithStatus calls GetSalesDocumentsWithStatusFunc
+func (m *SalesManagerMock) GetSalesDocumentsWithStatus(ctx context.Context, filters map[string]string) (r0 *sales.GetSalesDocumentResponse, r1 error) {
+	m.record("GetSalesDocumentsWithStatus", ctx, filters)
+	if m.GetSalesDocumentsWithStatusFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetSalesDocumentsWithStatus")
+		return
+	}
+	return m.GetSalesDocumentsWithStatusFunc(ctx, filters)
+}
+
+//GetSalesDocumentsBulk calls GetSalesDocumentsBulkFunc
+func (m *SalesManagerMock) GetSalesDocumentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetSaleDocumentResponseBulk, r1 error) {
+	m.record("GetSalesDocumentsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetSalesDocumentsBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetSalesDocumentsBulk")
+		return
+	}
+	return m.GetSalesDocumentsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteDocument calls DeleteDocumentFunc
+func (m *SalesManagerMock) DeleteDocument(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeleteDocument", ctx, filters)
+	if m.DeleteDocumentFunc == nil {
+		r0 = notImplemented("sales.Manager", "DeleteDocument")
+		return
+	}
+	return m.DeleteDocumentFunc(ctx, filters)
+}
+
+//SavePurchaseDocument calls SavePurchaseDocumentFunc
+func (m *SalesManagerMock) SavePurchaseDocument(ctx context.Context, filters map[string]string) (r0 sales.PurchaseDocImportReports, r1 error) {
+	m.record("SavePurchaseDocument", ctx, filters)
+	if m.SavePurchaseDocumentFunc == nil {
+		r1 = notImplemented("sales.Manager", "SavePurchaseDocument")
+		return
+	}
+	return m.SavePurchaseDocumentFunc(ctx, filters)
+}
+
+//SavePurchaseDocumentBulk calls SavePurchaseDocumentBulkFunc
+func (m *SalesManagerMock) SavePurchaseDocumentBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SavePurchaseDocumentResponseBulk, r1 error) {
+	m.record("SavePurchaseDocumentBulk", ctx, bulkFilters, baseFilters)
+	if m.SavePurchaseDocumentBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "SavePurchaseDocumentBulk")
+		return
+	}
+	return m.SavePurchaseDocumentBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeleteDocumentsBulk calls DeleteDocumentsBulkFunc
+func (m *SalesManagerMock) DeleteDocumentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.DeleteResponseBulk, r1 error) {
+	m.record("DeleteDocumentsBulk", ctx, bulkFilters, baseFilters)
+	if m.DeleteDocumentsBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "DeleteDocumentsBulk")
+		return
+	}
+	return m.DeleteDocumentsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetVatRates calls GetVatRatesFunc
+func (m *SalesManagerMock) GetVatRates(ctx context.Context, filters map[string]string) (r0 sales.VatRates, r1 error) {
+	m.record("GetVatRates", ctx, filters)
+	if m.GetVatRatesFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetVatRates")
+		return
+	}
+	return m.GetVatRatesFunc(ctx, filters)
+}
+
+//GetVatRatesBulk calls GetVatRatesBulkFunc
+func (m *SalesManagerMock) GetVatRatesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetVatRatesResponseBulk, r1 error) {
+	m.record("GetVatRatesBulk", ctx, bulkFilters, baseFilters)
+	if m.GetVatRatesBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetVatRatesBulk")
+		return
+	}
+	return m.GetVatRatesBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//SaveVatRate calls SaveVatRateFunc
+func (m *SalesManagerMock) SaveVatRate(ctx context.Context, filters map[string]string) (r0 *sales.SaveVatRateResult, r1 error) {
+	m.record("SaveVatRate", ctx, filters)
+	if m.SaveVatRateFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveVatRate")
+		return
+	}
+	return m.SaveVatRateFunc(ctx, filters)
+}
+
+//SaveVatRateBulk calls SaveVatRateBulkFunc
+func (m *SalesManagerMock) SaveVatRateBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveVatRateResponseBulk, r1 error) {
+	m.record("SaveVatRateBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveVatRateBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveVatRateBulk")
+		return
+	}
+	return m.SaveVatRateBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveVatRateComponent calls SaveVatRateComponentFunc
+func (m *SalesManagerMock) SaveVatRateComponent(ctx context.Context, filters map[string]string) (r0 *sales.SaveVatRateComponentResult, r1 error) {
+	m.record("SaveVatRateComponent", ctx, filters)
+	if m.SaveVatRateComponentFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveVatRateComponent")
+		return
+	}
+	return m.SaveVatRateComponentFunc(ctx, filters)
+}
+
+//SaveVatRateComponentBulk calls SaveVatRateComponentBulkFunc
+func (m *SalesManagerMock) SaveVatRateComponentBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 sales.SaveVatRateComponentResponseBulk, r1 error) {
+	m.record("SaveVatRateComponentBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveVatRateComponentBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveVatRateComponentBulk")
+		return
+	}
+	return m.SaveVatRateComponentBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveAssignment calls SaveAssignmentFunc
+func (m *SalesManagerMock) SaveAssignment(ctx context.Context, filters map[string]string) (r0 int64, r1 error) {
+	m.record("SaveAssignment", ctx, filters)
+	if m.SaveAssignmentFunc == nil {
+		r1 = notImplemented("sales.Manager", "SaveAssignment")
+		return
+	}
+	return m.SaveAssignmentFunc(ctx, filters)
+}
+
+//GetSalesReport calls GetSalesReportFunc
+func (m *SalesManagerMock) GetSalesReport(ctx context.Context, filters map[string]string) (r0 *sales.GetSalesReport, r1 error) {
+	m.record("GetSalesReport", ctx, filters)
+	if m.GetSalesReportFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetSalesReport")
+		return
+	}
+	return m.GetSalesReportFunc(ctx, filters)
+}
+
+//GetCoupons calls GetCouponsFunc
+func (m *SalesManagerMock) GetCoupons(ctx context.Context, filters map[string]string) (r0 *sales.GetCouponsResponse, r1 error) {
+	m.record("GetCoupons", ctx, filters)
+	if m.GetCouponsFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetCoupons")
+		return
+	}
+	return m.GetCouponsFunc(ctx, filters)
+}
+
+//SavePayment calls SavePaymentFunc
+func (m *SalesManagerMock) SavePayment(ctx context.Context, filters map[string]string) (r0 int64, r1 error) {
+	m.record("SavePayment", ctx, filters)
+	if m.SavePaymentFunc == nil {
+		r1 = notImplemented("sales.Manager", "SavePayment")
+		return
+	}
+	return m.SavePaymentFunc(ctx, filters)
+}
+
+//SavePaymentsBulk calls SavePaymentsBulkFunc
+func (m *SalesManagerMock) SavePaymentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.SavePaymentsResponseBulk, r1 error) {
+	m.record("SavePaymentsBulk", ctx, bulkFilters, baseFilters)
+	if m.SavePaymentsBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "SavePaymentsBulk")
+		return
+	}
+	return m.SavePaymentsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//GetPayments calls GetPaymentsFunc
+func (m *SalesManagerMock) GetPayments(ctx context.Context, filters map[string]string) (r0 []sales.PaymentInfo, r1 error) {
+	m.record("GetPayments", ctx, filters)
+	if m.GetPaymentsFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetPayments")
+		return
+	}
+	return m.GetPaymentsFunc(ctx, filters)
+}
+
+//GetPaymentsBulk calls GetPaymentsBulkFunc
+func (m *SalesManagerMock) GetPaymentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.GetPaymentsResponseBulk, r1 error) {
+	m.record("GetPaymentsBulk", ctx, bulkFilters, baseFilters)
+	if m.GetPaymentsBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "GetPaymentsBulk")
+		return
+	}
+	return m.GetPaymentsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//DeletePayment calls DeletePaymentFunc
+func (m *SalesManagerMock) DeletePayment(ctx context.Context, filters map[string]string) (r0 error) {
+	m.record("DeletePayment", ctx, filters)
+	if m.DeletePaymentFunc == nil {
+		r0 = notImplemented("sales.Manager", "DeletePayment")
+		return
+	}
+	return m.DeletePaymentFunc(ctx, filters)
+}
+
+//DeletePaymentsBulk calls DeletePaymentsBulkFunc
+func (m *SalesManagerMock) DeletePaymentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (r0 sales.DeleteResponseBulk, r1 error) {
+	m.record("DeletePaymentsBulk", ctx, bulkFilters, baseFilters)
+	if m.DeletePaymentsBulkFunc == nil {
+		r1 = notImplemented("sales.Manager", "DeletePaymentsBulk")
+		return
+	}
+	return m.DeletePaymentsBulkFunc(ctx, bulkFilters, baseFilters)
+}
+
+//CalculateShoppingCart calls CalculateShoppingCartFunc
+func (m *SalesManagerMock) CalculateShoppingCart(ctx context.Context, filters map[string]string) (r0 *sales.ShoppingCartTotals, r1 error) {
+	m.record("CalculateShoppingCart", ctx, filters)
+	if m.CalculateShoppingCartFunc == nil {
+		r1 = notImplemented("sales.Manager", "CalculateShoppingCart")
+		return
+	}
+	return m.CalculateShoppingCartFunc(ctx, filters)
+}
+
+//CalculateShoppingCartWithFullRowsResponse calls CalculateShoppingCartWithFullRowsResponseFunc
+func (m *SalesManagerMock) CalculateShoppingCartWithFullRowsResponse(ctx context.Context, filters map[string]string) (r0 *sales.ShoppingCartTotalsWithFullRows, r1 error) {
+	m.record("CalculateShoppingCartWithFullRowsResponse", ctx, filters)
+	if m.CalculateShoppingCartWithFullRowsResponseFunc == nil {
+		r1 = notImplemented("sales.Manager", "CalculateShoppingCartWithFullRowsResponse")
+		return
+	}
+	return m.CalculateShoppingCartWithFullRowsResponseFunc(ctx, filters)
+}
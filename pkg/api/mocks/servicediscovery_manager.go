@@ -0,0 +1,37 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/servicediscovery"
+)
+
+var _ servicediscovery.Manager = (*ServiceDiscoveryManagerMock)(nil)
+
+//ServiceDiscoveryManagerMock mocks servicediscovery.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type ServiceDiscoveryManagerMock struct {
+	CallRecorder
+	GetServiceEndpointsFunc func(ctx context.Context) (*servicediscovery.ServiceEndpoints, error)
+}
+
+//NewServiceDiscoveryManagerMock creates a mock where all methods return ErrNotImplemented
+func NewServiceDiscoveryManagerMock() *ServiceDiscoveryManagerMock {
+	return &ServiceDiscoveryManagerMock{
+		GetServiceEndpointsFunc: func(ctx context.Context) (r0 *servicediscovery.ServiceEndpoints, r1 error) {
+			r1 = notImplemented("servicediscovery.Manager", "GetServiceEndpoints")
+			return
+		},
+	}
+}
+
+//GetServiceEndpoints calls GetServiceEndpointsFunc
+func (m *ServiceDiscoveryManagerMock) GetServiceEndpoints(ctx context.Context) (r0 *servicediscovery.ServiceEndpoints, r1 error) {
+	m.record("GetServiceEndpoints", ctx)
+	if m.GetServiceEndpointsFunc == nil {
+		r1 = notImplemented("servicediscovery.Manager", "GetServiceEndpoints")
+		return
+	}
+	return m.GetServiceEndpointsFunc(ctx)
+}
@@ -0,0 +1,97 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/warehouse"
+)
+
+var _ warehouse.InventoryManager = (*InventoryManagerMock)(nil)
+
+//InventoryManagerMock mocks warehouse.InventoryManager, the calls are forwarded to the function fields and recorded with their arguments
+type InventoryManagerMock struct {
+	CallRecorder
+	SaveInventoryRegistrationFunc     func(ctx context.Context, filters map[string]string) (int, error)
+	SaveInventoryRegistrationBulkFunc func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (warehouse.SaveInventoryRegistrationResponseBulk, error)
+	SaveInventoryWriteOffFunc         func(ctx context.Context, filters map[string]string) (int, error)
+	SaveInventoryTransferFunc         func(ctx context.Context, filters map[string]string) (int, error)
+	GetReasonCodesFunc                func(ctx context.Context, filters map[string]string) ([]warehouse.ReasonCode, error)
+}
+
+//NewInventoryManagerMock creates a mock where all methods return ErrNotImplemented
+func NewInventoryManagerMock() *InventoryManagerMock {
+	return &InventoryManagerMock{
+		SaveInventoryRegistrationFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryRegistration")
+			return
+		},
+		SaveInventoryRegistrationBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveInventoryRegistrationResponseBulk, r1 error) {
+			r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryRegistrationBulk")
+			return
+		},
+		SaveInventoryWriteOffFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryWriteOff")
+			return
+		},
+		SaveInventoryTransferFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryTransfer")
+			return
+		},
+		GetReasonCodesFunc: func(ctx context.Context, filters map[string]string) (r0 []warehouse.ReasonCode, r1 error) {
+			r1 = notImplemented("warehouse.InventoryManager", "GetReasonCodes")
+			return
+		},
+	}
+}
+
+//SaveInventoryRegistration calls SaveInventoryRegistrationFunc
+func (m *InventoryManagerMock) SaveInventoryRegistration(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryRegistration", ctx, filters)
+	if m.SaveInventoryRegistrationFunc == nil {
+		r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryRegistration")
+		return
+	}
+	return m.SaveInventoryRegistrationFunc(ctx, filters)
+}
+
+//SaveInventoryRegistrationBulk calls SaveInventoryRegistrationBulkFunc
+func (m *InventoryManagerMock) SaveInventoryRegistrationBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveInventoryRegistrationResponseBulk, r1 error) {
+	m.record("SaveInventoryRegistrationBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveInventoryRegistrationBulkFunc == nil {
+		r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryRegistrationBulk")
+		return
+	}
+	return m.SaveInventoryRegistrationBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveInventoryWriteOff calls SaveInventoryWriteOffFunc
+func (m *InventoryManagerMock) SaveInventoryWriteOff(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryWriteOff", ctx, filters)
+	if m.SaveInventoryWriteOffFunc == nil {
+		r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryWriteOff")
+		return
+	}
+	return m.SaveInventoryWriteOffFunc(ctx, filters)
+}
+
+//SaveInventoryTransfer calls SaveInventoryTransferFunc
+func (m *InventoryManagerMock) SaveInventoryTransfer(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryTransfer", ctx, filters)
+	if m.SaveInventoryTransferFunc == nil {
+		r1 = notImplemented("warehouse.InventoryManager", "SaveInventoryTransfer")
+		return
+	}
+	return m.SaveInventoryTransferFunc(ctx, filters)
+}
+
+//GetReasonCodes calls GetReasonCodesFunc
+func (m *InventoryManagerMock) GetReasonCodes(ctx context.Context, filters map[string]string) (r0 []warehouse.ReasonCode, r1 error) {
+	m.record("GetReasonCodes", ctx, filters)
+	if m.GetReasonCodesFunc == nil {
+		r1 = notImplemented("warehouse.InventoryManager", "GetReasonCodes")
+		return
+	}
+	return m.GetReasonCodesFunc(ctx, filters)
+}
@@ -0,0 +1,172 @@
+// Code generated by mocks/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/erply/api-go-wrapper/pkg/api/warehouse"
+)
+
+var _ warehouse.Manager = (*WarehouseManagerMock)(nil)
+
+//WarehouseManagerMock mocks warehouse.Manager, the calls are forwarded to the function fields and recorded with their arguments
+type WarehouseManagerMock struct {
+	CallRecorder
+	GetWarehousesFunc                 func(ctx context.Context, filters map[string]string) (warehouse.Warehouses, error)
+	GetWarehousesWithStatusFunc       func(ctx context.Context, filters map[string]string) (*warehouse.GetWarehousesResponse, error)
+	GetWarehousesBulkFunc             func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (warehouse.GetWarehousesResponseBulk, error)
+	SaveWarehouseFunc                 func(ctx context.Context, filters map[string]string) (*warehouse.SaveWarehouseResult, error)
+	SaveWarehouseBulkFunc             func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (warehouse.SaveWarehouseResponseBulk, error)
+	SaveInventoryRegistrationFunc     func(ctx context.Context, filters map[string]string) (int, error)
+	SaveInventoryRegistrationBulkFunc func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (warehouse.SaveInventoryRegistrationResponseBulk, error)
+	SaveInventoryWriteOffFunc         func(ctx context.Context, filters map[string]string) (int, error)
+	SaveInventoryTransferFunc         func(ctx context.Context, filters map[string]string) (int, error)
+	GetReasonCodesFunc                func(ctx context.Context, filters map[string]string) ([]warehouse.ReasonCode, error)
+}
+
+//NewWarehouseManagerMock creates a mock where all methods return ErrNotImplemented
+func NewWarehouseManagerMock() *WarehouseManagerMock {
+	return &WarehouseManagerMock{
+		GetWarehousesFunc: func(ctx context.Context, filters map[string]string) (r0 warehouse.Warehouses, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "GetWarehouses")
+			return
+		},
+		GetWarehousesWithStatusFunc: func(ctx context.Context, filters map[string]string) (r0 *warehouse.GetWarehousesResponse, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "GetWarehousesWithStatus")
+			return
+		},
+		GetWarehousesBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.GetWarehousesResponseBulk, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "GetWarehousesBulk")
+			return
+		},
+		SaveWarehouseFunc: func(ctx context.Context, filters map[string]string) (r0 *warehouse.SaveWarehouseResult, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveWarehouse")
+			return
+		},
+		SaveWarehouseBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveWarehouseResponseBulk, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveWarehouseBulk")
+			return
+		},
+		SaveInventoryRegistrationFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveInventoryRegistration")
+			return
+		},
+		SaveInventoryRegistrationBulkFunc: func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveInventoryRegistrationResponseBulk, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveInventoryRegistrationBulk")
+			return
+		},
+		SaveInventoryWriteOffFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveInventoryWriteOff")
+			return
+		},
+		SaveInventoryTransferFunc: func(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "SaveInventoryTransfer")
+			return
+		},
+		GetReasonCodesFunc: func(ctx context.Context, filters map[string]string) (r0 []warehouse.ReasonCode, r1 error) {
+			r1 = notImplemented("warehouse.Manager", "GetReasonCodes")
+			return
+		},
+	}
+}
+
+//GetWarehouses calls GetWarehousesFunc
+func (m *WarehouseManagerMock) GetWarehouses(ctx context.Context, filters map[string]string) (r0 warehouse.Warehouses, r1 error) {
+	m.record("GetWarehouses", ctx, filters)
+	if m.GetWarehousesFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "GetWarehouses")
+		return
+	}
+	return m.GetWarehousesFunc(ctx, filters)
+}
+
+//GetWarehousesWithStatus calls GetWarehousesWithStatusFunc
+func (m *WarehouseManagerMock) GetWarehousesWithStatus(ctx context.Context, filters map[string]string) (r0 *warehouse.GetWarehousesResponse, r1 error) {
+	m.record("GetWarehousesWithStatus", ctx, filters)
+	if m.GetWarehousesWithStatusFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "GetWarehousesWithStatus")
+		return
+	}
+	return m.GetWarehousesWithStatusFunc(ctx, filters)
+}
+
+//GetWarehousesBulk calls GetWarehousesBulkFunc
+func (m *WarehouseManagerMock) GetWarehousesBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.GetWarehousesResponseBulk, r1 error) {
+	m.record("GetWarehousesBulk", ctx, bulkRequest, baseFilters)
+	if m.GetWarehousesBulkFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "GetWarehousesBulk")
+		return
+	}
+	return m.GetWarehousesBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveWarehouse calls SaveWarehouseFunc
+func (m *WarehouseManagerMock) SaveWarehouse(ctx context.Context, filters map[string]string) (r0 *warehouse.SaveWarehouseResult, r1 error) {
+	m.record("SaveWarehouse", ctx, filters)
+	if m.SaveWarehouseFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveWarehouse")
+		return
+	}
+	return m.SaveWarehouseFunc(ctx, filters)
+}
+
+//SaveWarehouseBulk calls SaveWarehouseBulkFunc
+func (m *WarehouseManagerMock) SaveWarehouseBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveWarehouseResponseBulk, r1 error) {
+	m.record("SaveWarehouseBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveWarehouseBulkFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveWarehouseBulk")
+		return
+	}
+	return m.SaveWarehouseBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveInventoryRegistration calls SaveInventoryRegistrationFunc
+func (m *WarehouseManagerMock) SaveInventoryRegistration(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryRegistration", ctx, filters)
+	if m.SaveInventoryRegistrationFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveInventoryRegistration")
+		return
+	}
+	return m.SaveInventoryRegistrationFunc(ctx, filters)
+}
+
+//SaveInventoryRegistrationBulk calls SaveInventoryRegistrationBulkFunc
+func (m *WarehouseManagerMock) SaveInventoryRegistrationBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (r0 warehouse.SaveInventoryRegistrationResponseBulk, r1 error) {
+	m.record("SaveInventoryRegistrationBulk", ctx, bulkRequest, baseFilters)
+	if m.SaveInventoryRegistrationBulkFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveInventoryRegistrationBulk")
+		return
+	}
+	return m.SaveInventoryRegistrationBulkFunc(ctx, bulkRequest, baseFilters)
+}
+
+//SaveInventoryWriteOff calls SaveInventoryWriteOffFunc
+func (m *WarehouseManagerMock) SaveInventoryWriteOff(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryWriteOff", ctx, filters)
+	if m.SaveInventoryWriteOffFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveInventoryWriteOff")
+		return
+	}
+	return m.SaveInventoryWriteOffFunc(ctx, filters)
+}
+
+//SaveInventoryTransfer calls SaveInventoryTransferFunc
+func (m *WarehouseManagerMock) SaveInventoryTransfer(ctx context.Context, filters map[string]string) (r0 int, r1 error) {
+	m.record("SaveInventoryTransfer", ctx, filters)
+	if m.SaveInventoryTransferFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "SaveInventoryTransfer")
+		return
+	}
+	return m.SaveInventoryTransferFunc(ctx, filters)
+}
+
+//GetReasonCodes calls GetReasonCodesFunc
+func (m *WarehouseManagerMock) GetReasonCodes(ctx context.Context, filters map[string]string) (r0 []warehouse.ReasonCode, r1 error) {
+	m.record("GetReasonCodes", ctx, filters)
+	if m.GetReasonCodesFunc == nil {
+		r1 = notImplemented("warehouse.Manager", "GetReasonCodes")
+		return
+	}
+	return m.GetReasonCodesFunc(ctx, filters)
+}
@@ -69,10 +69,6 @@ func (cli *Client) persistProductToSupplierPriceList(ctx context.Context, method
 func (cli *Client) ChangeProductToSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (ChangeProductToSupplierPriceListResponseBulk, error) {
 	var bulkResp ChangeProductToSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot add more than %d products to price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, prodPrice := range bulkRequest {
 		_, isEditMode := prodPrice["supplierPriceListProductID"]
@@ -104,14 +100,10 @@ func (cli *Client) ChangeProductToSupplierPriceListBulk(ctx context.Context, bul
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -143,10 +135,10 @@ func (cli *Client) GetSupplierPriceListsBulk(ctx context.Context, bulkFilters []
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -178,10 +170,10 @@ func (cli *Client) GetPriceListsBulk(ctx context.Context, bulkFilters []map[stri
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -235,10 +227,10 @@ func (cli *Client) GetProductsInSupplierPriceListBulk(ctx context.Context, bulkF
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -314,10 +306,10 @@ func (cli *Client) GetProductsInPriceListBulk(ctx context.Context, bulkFilters [
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -352,10 +344,6 @@ func (cli *Client) DeleteProductsFromSupplierPriceList(ctx context.Context, filt
 func (cli *Client) DeleteProductsFromSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (DeleteProductsFromSupplierPriceListResponseBulk, error) {
 	var bulkResp DeleteProductsFromSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d products from price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -382,14 +370,10 @@ func (cli *Client) DeleteProductsFromSupplierPriceListBulk(ctx context.Context,
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -424,10 +408,6 @@ func (cli *Client) SaveSupplierPriceList(ctx context.Context, filters map[string
 func (cli *Client) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveSupplierPriceListResponseBulk, error) {
 	var bulkResp SaveSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -454,14 +434,10 @@ func (cli *Client) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -518,10 +494,6 @@ func (cli *Client) SavePriceList(ctx context.Context, filters map[string]string)
 func (cli *Client) SavePriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SavePriceListResponseBulk, error) {
 	var bulkResp SavePriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -548,14 +520,10 @@ func (cli *Client) SavePriceListBulk(ctx context.Context, bulkRequest []map[stri
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -598,10 +566,6 @@ func (cli *Client) persistProductToPriceList(ctx context.Context, method string,
 func (cli *Client) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (ChangeProductToPriceListResponseBulk, error) {
 	var bulkResp ChangeProductToPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot add more than %d products to price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, prodPrice := range bulkRequest {
 		_, isEditMode := prodPrice["priceListProductID"]
@@ -633,14 +597,10 @@ func (cli *Client) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
@@ -678,10 +638,6 @@ func (cli *Client) DeleteProductsFromPriceListBulk(
 ) (DeleteProductsFromPriceListResponseBulk, error) {
 	var bulkResp DeleteProductsFromPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d products from price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -708,14 +664,10 @@ func (cli *Client) DeleteProductsFromPriceListBulk(
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
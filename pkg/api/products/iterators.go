@@ -0,0 +1,212 @@
+package products
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//ProductsIterator gives the products of a listing one by one
+type ProductsIterator struct {
+	*sharedCommon.ItemsIterator
+	value Product
+}
+
+//IterateProducts lists the products matching the filters with ListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateProducts(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *ProductsIterator {
+	lister := sharedCommon.NewLister(settings, NewListingDataProvider(erplyClient), time.Sleep)
+	return NewProductsIterator(ctx, lister, filters)
+}
+
+//NewProductsIterator iterates over the items of a lister which was created with NewListingDataProvider
+func NewProductsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *ProductsIterator {
+	return &ProductsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *ProductsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(Product)
+	if !ok {
+		it.FailUnexpectedPayload("products.Product")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *ProductsIterator) Value() Product {
+	return it.value
+}
+
+//ForEachProduct calls f for every item of IterateProducts, the iteration stops at the first error of the listing or f
+func ForEachProduct(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(product Product) error) error {
+	it := IterateProducts(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//ProductCategoriesIterator gives the product categories of a listing one by one
+type ProductCategoriesIterator struct {
+	*sharedCommon.ItemsIterator
+	value ProductCategory
+}
+
+//IterateProductCategories lists the product categories matching the filters with ProductCategoriesListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateProductCategories(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *ProductCategoriesIterator {
+	lister := sharedCommon.NewLister(settings, NewProductCategoriesListingDataProvider(erplyClient), time.Sleep)
+	return NewProductCategoriesIterator(ctx, lister, filters)
+}
+
+//NewProductCategoriesIterator iterates over the items of a lister which was created with NewProductCategoriesListingDataProvider
+func NewProductCategoriesIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *ProductCategoriesIterator {
+	return &ProductCategoriesIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *ProductCategoriesIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(ProductCategory)
+	if !ok {
+		it.FailUnexpectedPayload("products.ProductCategory")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *ProductCategoriesIterator) Value() ProductCategory {
+	return it.value
+}
+
+//ForEachProductCategory calls f for every item of IterateProductCategories, the iteration stops at the first error of the listing or f
+func ForEachProductCategory(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(category ProductCategory) error) error {
+	it := IterateProductCategories(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//ProductGroupsIterator gives the product groups of a listing one by one
+type ProductGroupsIterator struct {
+	*sharedCommon.ItemsIterator
+	value ProductGroup
+}
+
+//IterateProductGroups lists the product groups matching the filters with ProductGroupsListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateProductGroups(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *ProductGroupsIterator {
+	lister := sharedCommon.NewLister(settings, NewProductGroupsListingDataProvider(erplyClient), time.Sleep)
+	return NewProductGroupsIterator(ctx, lister, filters)
+}
+
+//NewProductGroupsIterator iterates over the items of a lister which was created with NewProductGroupsListingDataProvider
+func NewProductGroupsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *ProductGroupsIterator {
+	return &ProductGroupsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *ProductGroupsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(ProductGroup)
+	if !ok {
+		it.FailUnexpectedPayload("products.ProductGroup")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *ProductGroupsIterator) Value() ProductGroup {
+	return it.value
+}
+
+//ForEachProductGroup calls f for every item of IterateProductGroups, the iteration stops at the first error of the listing or f
+func ForEachProductGroup(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(group ProductGroup) error) error {
+	it := IterateProductGroups(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//ProductPriorityGroupsIterator gives the product priority groups of a listing one by one
+type ProductPriorityGroupsIterator struct {
+	*sharedCommon.ItemsIterator
+	value ProductPriorityGroup
+}
+
+//IterateProductPriorityGroups lists the product priority groups matching the filters with PrioGroupListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateProductPriorityGroups(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *ProductPriorityGroupsIterator {
+	lister := sharedCommon.NewLister(settings, NewPrioGroupListingDataProvider(erplyClient), time.Sleep)
+	return NewProductPriorityGroupsIterator(ctx, lister, filters)
+}
+
+//NewProductPriorityGroupsIterator iterates over the items of a lister which was created with NewPrioGroupListingDataProvider
+func NewProductPriorityGroupsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *ProductPriorityGroupsIterator {
+	return &ProductPriorityGroupsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *ProductPriorityGroupsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(ProductPriorityGroup)
+	if !ok {
+		it.FailUnexpectedPayload("products.ProductPriorityGroup")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *ProductPriorityGroupsIterator) Value() ProductPriorityGroup {
+	return it.value
+}
+
+//ForEachProductPriorityGroup calls f for every item of IterateProductPriorityGroups, the iteration stops at the first error of the listing or f
+func ForEachProductPriorityGroup(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(group ProductPriorityGroup) error) error {
+	it := IterateProductPriorityGroups(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
@@ -0,0 +1,58 @@
+package products
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterateProducts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parsedRequest, err := common.ExtractBulkFiltersFromRequest(r)
+		assert.NoError(t, err)
+
+		requests := parsedRequest["requests"].([]map[string]interface{})
+		if requests[0]["recordsOnPage"] == float64(1) {
+			assert.NoError(t, sendRequest(w, 0, 3, [][]int{{1}}))
+			return
+		}
+		assert.NoError(t, sendRequest(w, 0, 3, [][]int{{1, 2, 3}}))
+	}))
+	defer srv.Close()
+
+	baseClient := common.NewClient("somesess", "someclient", "", nil, nil)
+	baseClient.Url = srv.URL
+	productsClient := NewClient(baseClient)
+
+	it := IterateProducts(context.Background(), productsClient, nil, sharedCommon.ListingSettings{})
+	ids := []int{}
+	for it.Next() {
+		ids = append(ids, it.Value().ProductID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 3, it.TotalCount())
+
+	ids = []int{}
+	err := ForEachProduct(context.Background(), productsClient, nil, sharedCommon.ListingSettings{}, func(product Product) error {
+		ids = append(ids, product.ProductID)
+		if len(ids) == 2 {
+			return errors.New("stop")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "stop")
+	assert.Equal(t, []int{1, 2}, ids)
+
+	//a lister of another data provider fails instead of panicking
+	lister := sharedCommon.NewLister(sharedCommon.ListingSettings{}, NewProductGroupsListingDataProvider(productsClient), nil)
+	groupsIt := NewProductsIterator(context.Background(), lister, nil)
+	assert.False(t, groupsIt.Next())
+	assert.Error(t, groupsIt.Err())
+}
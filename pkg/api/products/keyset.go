@@ -0,0 +1,26 @@
+package products
+
+import (
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//ProductsKeyset pages the products by their lastModified time with the changedSince filter, see Lister.SetKeysetPagination
+func ProductsKeyset() *sharedCommon.KeysetPagination {
+	return &sharedCommon.KeysetPagination{
+		KeyFilter:    "changedSince",
+		OrderFilters: map[string]interface{}{"orderBy": "changed", "orderByDir": "asc"},
+		ItemKey: func(item interface{}) (int64, int, bool) {
+			product, ok := item.(Product)
+			return int64(product.LastModified), product.ProductID, ok
+		},
+	}
+}
+
+//NewProductsKeysetLister creates a lister of NewListingDataProvider which uses ProductsKeyset instead of the offset pages
+func NewProductsKeysetLister(erplyClient Manager, settings sharedCommon.ListingSettings) *sharedCommon.Lister {
+	lister := sharedCommon.NewLister(settings, NewListingDataProvider(erplyClient), time.Sleep)
+	lister.SetKeysetPagination(ProductsKeyset())
+	return lister
+}
@@ -100,10 +100,10 @@ func (cli *Client) GetProductsBulk(ctx context.Context, bulkFilters []map[string
 		return productsResp, sharedCommon.NewErplyError(productsResp.Status.ErrorCode.String(), productsResp.Status.Request+": "+productsResp.Status.ResponseStatus, productsResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range productsResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, prodBulkItem.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(productsResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return productsResp.BulkItems[i].Status
+	}); err != nil {
+		return productsResp, err
 	}
 
 	return productsResp, nil
@@ -154,10 +154,10 @@ func (cli *Client) SaveProductBulk(ctx context.Context, bulkFilters []map[string
 		return productsResp, sharedCommon.NewErplyError(productsResp.Status.ErrorCode.String(), productsResp.Status.Request+": "+productsResp.Status.ResponseStatus, productsResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range productsResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, productsResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(productsResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return productsResp.BulkItems[i].Status
+	}); err != nil {
+		return productsResp, err
 	}
 
 	return productsResp, nil
@@ -219,10 +219,10 @@ func (cli *Client) DeleteProductBulk(ctx context.Context, bulkFilters []map[stri
 		return deleteRespBulk, sharedCommon.NewErplyError(deleteRespBulk.Status.ErrorCode.String(), deleteRespBulk.Status.Request+": "+deleteRespBulk.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range deleteRespBulk.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return deleteRespBulk, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(deleteRespBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return deleteRespBulk.BulkItems[i].Status
+	}); err != nil {
+		return deleteRespBulk, err
 	}
 
 	return deleteRespBulk, nil
@@ -346,10 +346,10 @@ func (cli *Client) GetProductStockBulk(ctx context.Context, bulkFilters []map[st
 		return productsStockResp, sharedCommon.NewErplyError(productsStockResp.Status.ErrorCode.String(), productsStockResp.Status.Request+": "+productsStockResp.Status.ResponseStatus, productsStockResp.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range productsStockResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsStockResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, productsStockResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(productsStockResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return productsStockResp.BulkItems[i].Status
+	}); err != nil {
+		return productsStockResp, err
 	}
 
 	return productsStockResp, nil
@@ -386,14 +386,10 @@ func (cli *Client) GetProductStockFileBulk(ctx context.Context, bulkFilters []ma
 		)
 	}
 
-	for _, prodBulkItem := range productsStockResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsStockResp, sharedCommon.NewErplyError(
-				prodBulkItem.Status.ErrorCode.String(),
-				prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus,
-				productsStockResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(productsStockResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return productsStockResp.BulkItems[i].Status
+	}); err != nil {
+		return productsStockResp, err
 	}
 
 	return productsStockResp, nil
@@ -448,14 +444,10 @@ func (cli *Client) SaveAssortmentBulk(ctx context.Context, bulkFilters []map[str
 		)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(assortmentResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return assortmentResp.BulkItems[i].Status
+	}); err != nil {
+		return assortmentResp, err
 	}
 
 	return assortmentResp, nil
@@ -510,14 +502,10 @@ func (cli *Client) AddAssortmentProductsBulk(ctx context.Context, bulkFilters []
 		)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentItem.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(assortmentResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return assortmentResp.BulkItems[i].Status
+	}); err != nil {
+		return assortmentResp, err
 	}
 
 	return assortmentResp, nil
@@ -572,14 +560,10 @@ func (cli *Client) EditAssortmentProductsBulk(ctx context.Context, bulkFilters [
 		)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentItem.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(assortmentResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return assortmentResp.BulkItems[i].Status
+	}); err != nil {
+		return assortmentResp, err
 	}
 
 	return assortmentResp, nil
@@ -634,14 +618,10 @@ func (cli *Client) RemoveAssortmentProductsBulk(ctx context.Context, bulkFilters
 		)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentResp.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(assortmentResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return assortmentResp.BulkItems[i].Status
+	}); err != nil {
+		return assortmentResp, err
 	}
 
 	return assortmentResp, nil
@@ -699,14 +679,10 @@ func (cli *Client) SaveProductCategoryBulk(
 		)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus,
-				respBulk.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -760,10 +736,10 @@ func (cli *Client) SaveBrandBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -817,10 +793,10 @@ func (cli *Client) SaveProductPriorityGroupBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -857,10 +833,10 @@ func (cli *Client) GetProductPriorityGroupBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -897,10 +873,10 @@ func (cli *Client) GetProductCategoriesBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -937,10 +913,10 @@ func (cli *Client) GetProductGroupsBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -994,10 +970,10 @@ func (cli *Client) SaveProductGroupBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -1044,10 +1020,10 @@ func (cli *Client) DeleteProductGroupBulk(ctx context.Context, bulkFilters []map
 		return deleteRespBulk, sharedCommon.NewErplyError(deleteRespBulk.Status.ErrorCode.String(), deleteRespBulk.Status.Request+": "+deleteRespBulk.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
 	}
 
-	for _, prodBulkItem := range deleteRespBulk.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return deleteRespBulk, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(deleteRespBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return deleteRespBulk.BulkItems[i].Status
+	}); err != nil {
+		return deleteRespBulk, err
 	}
 
 	return deleteRespBulk, nil
@@ -1095,10 +1071,10 @@ func (cli *Client) GetProductPicturesBulk(ctx context.Context, bulkFilters []map
 		return productPicturesResp, sharedCommon.NewErplyError(productPicturesResp.Status.ErrorCode.String(), productPicturesResp.Status.Request+": "+productPicturesResp.Status.ResponseStatus, productPicturesResp.Status.ErrorCode)
 	}
 
-	for _, prodPicturesBulkItem := range productPicturesResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodPicturesBulkItem.Status) {
-			return productPicturesResp, sharedCommon.NewErplyError(prodPicturesBulkItem.Status.ErrorCode.String(), prodPicturesBulkItem.Status.Request+": "+prodPicturesBulkItem.Status.ResponseStatus, prodPicturesBulkItem.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(productPicturesResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return sharedCommon.StatusBulk{Status: productPicturesResp.BulkItems[i].Status}
+	}); err != nil {
+		return productPicturesResp, err
 	}
 
 	return productPicturesResp, nil
@@ -440,6 +440,86 @@ func TestSaveProductBulk(t *testing.T) {
 	assert.Equal(t, expectedStatus, bulkResp.BulkItems[2].Status)
 }
 
+func TestSaveProductBulkPartialFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		okStatus := sharedCommon.StatusBulk{}
+		okStatus.ResponseStatus = "ok"
+
+		failedStatus := sharedCommon.StatusBulk{RequestID: "2", RequestName: "saveProduct"}
+		failedStatus.ResponseStatus = "error"
+		failedStatus.ErrorCode = sharedCommon.InvalidFormat
+		failedStatus.ErrorField = "groupID"
+
+		failedStatus2 := sharedCommon.StatusBulk{RequestID: "3", RequestName: "saveProduct"}
+		failedStatus2.ResponseStatus = "error"
+		failedStatus2.ErrorCode = sharedCommon.RequiredParamMissing
+		failedStatus2.ErrorField = "code"
+
+		bulkResp := SaveProductResponseBulk{
+			Status: sharedCommon.Status{ResponseStatus: "ok"},
+			BulkItems: []SaveProductResponseBulkItem{
+				{
+					Status:   okStatus,
+					Products: []SaveProductResult{{ProductID: 123}},
+				},
+				{
+					Status: failedStatus,
+				},
+				{
+					Status: failedStatus2,
+				},
+				{
+					Status:   okStatus,
+					Products: []SaveProductResult{{ProductID: 126}},
+				},
+			},
+		}
+		jsonRaw, err := json.Marshal(bulkResp)
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	inpt := []map[string]interface{}{
+		{"groupID": "4", "code": "code1"},
+		{"groupID": "x", "code": "code2"},
+		{"groupID": "4"},
+		{"groupID": "4", "code": "code4"},
+	}
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	cl := NewClient(cli)
+
+	bulkResp, err := cl.SaveProductBulk(context.Background(), inpt, map[string]string{})
+	assert.Error(t, err)
+
+	bulkErr, ok := err.(*sharedCommon.BulkError)
+	assert.True(t, ok)
+	if !ok {
+		return
+	}
+
+	assert.Equal(t, []int{1, 2}, bulkErr.Failed())
+	assert.Equal(t, "2", bulkErr.Items[0].RequestID)
+	assert.Equal(t, "saveProduct", bulkErr.Items[0].RequestName)
+	assert.Equal(t, sharedCommon.InvalidFormat, bulkErr.Items[0].Code)
+	assert.Equal(t, "groupID", bulkErr.Items[0].ErrorField)
+	assert.Equal(t, "3", bulkErr.Items[1].RequestID)
+	assert.Equal(t, sharedCommon.RequiredParamMissing, bulkErr.Items[1].Code)
+	assert.Equal(t, "code", bulkErr.Items[1].ErrorField)
+	assert.Contains(t, err.Error(), sharedCommon.InvalidFormat.String())
+	assert.Contains(t, err.Error(), sharedCommon.RequiredParamMissing.String())
+
+	assert.Len(t, bulkResp.BulkItems, 4)
+	assert.Equal(t, []SaveProductResult{{ProductID: 123}}, bulkResp.BulkItems[0].Products)
+	assert.Equal(t, []SaveProductResult{{ProductID: 126}}, bulkResp.BulkItems[3].Products)
+}
+
 func TestDeleteProduct(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := DeleteProductResponse{
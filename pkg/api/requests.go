@@ -107,10 +107,10 @@ func (c *Client) GetEmployeesBulk(ctx context.Context, bulkFilters []map[string]
 		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
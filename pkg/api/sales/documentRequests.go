@@ -59,10 +59,10 @@ func (cli *Client) SaveSalesDocumentBulk(
 		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -115,14 +115,10 @@ func (cli *Client) SavePurchaseDocumentBulk(
 		)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus,
-				respBulk.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(respBulk.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return respBulk.BulkItems[i].Status
+	}); err != nil {
+		return respBulk, err
 	}
 
 	return respBulk, nil
@@ -202,14 +198,10 @@ func (cli *Client) GetSalesDocumentsBulk(ctx context.Context, bulkFilters []map[
 		)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus,
-				bulkItem.Status.ErrorCode,
-			)
-		}
+	if err := sharedCommon.NewBulkErrorFromStatuses(len(bulkResp.BulkItems), func(i int) sharedCommon.StatusBulk {
+		return bulkResp.BulkItems[i].Status
+	}); err != nil {
+		return bulkResp, err
 	}
 
 	return bulkResp, nil
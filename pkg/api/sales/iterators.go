@@ -0,0 +1,161 @@
+package sales
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//SaleDocumentsIterator gives the sale documents of a listing one by one
+type SaleDocumentsIterator struct {
+	*sharedCommon.ItemsIterator
+	value SaleDocument
+}
+
+//IterateSaleDocuments lists the sale documents matching the filters with SaleDocumentsListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateSaleDocuments(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *SaleDocumentsIterator {
+	lister := sharedCommon.NewLister(settings, NewSaleDocumentsListingDataProvider(erplyClient), time.Sleep)
+	return NewSaleDocumentsIterator(ctx, lister, filters)
+}
+
+//NewSaleDocumentsIterator iterates over the items of a lister which was created with NewSaleDocumentsListingDataProvider
+func NewSaleDocumentsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *SaleDocumentsIterator {
+	return &SaleDocumentsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *SaleDocumentsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(SaleDocument)
+	if !ok {
+		it.FailUnexpectedPayload("sales.SaleDocument")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *SaleDocumentsIterator) Value() SaleDocument {
+	return it.value
+}
+
+//ForEachSaleDocument calls f for every item of IterateSaleDocuments, the iteration stops at the first error of the listing or f
+func ForEachSaleDocument(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(document SaleDocument) error) error {
+	it := IterateSaleDocuments(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//VatRatesIterator gives the VAT rates of a listing one by one
+type VatRatesIterator struct {
+	*sharedCommon.ItemsIterator
+	value VatRate
+}
+
+//IterateVatRates lists the VAT rates matching the filters with VatRatesListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateVatRates(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *VatRatesIterator {
+	lister := sharedCommon.NewLister(settings, NewVatRatesListingDataProvider(erplyClient), time.Sleep)
+	return NewVatRatesIterator(ctx, lister, filters)
+}
+
+//NewVatRatesIterator iterates over the items of a lister which was created with NewVatRatesListingDataProvider
+func NewVatRatesIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *VatRatesIterator {
+	return &VatRatesIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *VatRatesIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(VatRate)
+	if !ok {
+		it.FailUnexpectedPayload("sales.VatRate")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *VatRatesIterator) Value() VatRate {
+	return it.value
+}
+
+//ForEachVatRate calls f for every item of IterateVatRates, the iteration stops at the first error of the listing or f
+func ForEachVatRate(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(vatRate VatRate) error) error {
+	it := IterateVatRates(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+//PaymentsIterator gives the payments of a listing one by one
+type PaymentsIterator struct {
+	*sharedCommon.ItemsIterator
+	value PaymentInfo
+}
+
+//IteratePayments lists the payments matching the filters with PaymentsListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IteratePayments(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *PaymentsIterator {
+	lister := sharedCommon.NewLister(settings, NewPaymentsListingDataProvider(erplyClient), time.Sleep)
+	return NewPaymentsIterator(ctx, lister, filters)
+}
+
+//NewPaymentsIterator iterates over the items of a lister which was created with NewPaymentsListingDataProvider
+func NewPaymentsIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *PaymentsIterator {
+	return &PaymentsIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *PaymentsIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(PaymentInfo)
+	if !ok {
+		it.FailUnexpectedPayload("sales.PaymentInfo")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *PaymentsIterator) Value() PaymentInfo {
+	return it.value
+}
+
+//ForEachPaymentInfo calls f for every item of IteratePayments, the iteration stops at the first error of the listing or f
+func ForEachPaymentInfo(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(payment PaymentInfo) error) error {
+	it := IteratePayments(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
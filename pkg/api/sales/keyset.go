@@ -0,0 +1,26 @@
+package sales
+
+import (
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//SaleDocumentsKeyset pages the sale documents by their lastModified time with the changedSince filter, see Lister.SetKeysetPagination
+func SaleDocumentsKeyset() *sharedCommon.KeysetPagination {
+	return &sharedCommon.KeysetPagination{
+		KeyFilter:    "changedSince",
+		OrderFilters: map[string]interface{}{"orderBy": "changed", "orderByDir": "asc"},
+		ItemKey: func(item interface{}) (int64, int, bool) {
+			document, ok := item.(SaleDocument)
+			return document.LastModified, document.ID, ok
+		},
+	}
+}
+
+//NewSaleDocumentsKeysetLister creates a lister of NewSaleDocumentsListingDataProvider which uses SaleDocumentsKeyset instead of the offset pages
+func NewSaleDocumentsKeysetLister(erplyClient Manager, settings sharedCommon.ListingSettings) *sharedCommon.Lister {
+	lister := sharedCommon.NewLister(settings, NewSaleDocumentsListingDataProvider(erplyClient), time.Sleep)
+	lister.SetKeysetPagination(SaleDocumentsKeyset())
+	return lister
+}
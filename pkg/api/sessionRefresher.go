@@ -0,0 +1,245 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+const (
+	DefaultSessionRenewalMargin     = 5 * time.Minute
+	DefaultSessionRenewalMinBackoff = time.Second
+	DefaultSessionRenewalMaxBackoff = 5 * time.Minute
+)
+
+//SessionEventType tells what happened with the session in the background
+type SessionEventType int
+
+const (
+	//SessionRenewed means that a new session key is obtained
+	SessionRenewed SessionEventType = iota
+	//SessionRenewalFailed means that the login failed, it will be repeated after SessionEvent.RetryIn
+	SessionRenewalFailed
+)
+
+//SessionEvent is sent by SessionRefresher after each renewal attempt
+type SessionEvent struct {
+	Type SessionEventType
+	//ValidTill is the expiry time of the new session
+	ValidTill time.Time
+	Err       error
+	//RetryIn is the waiting time before the next attempt after a failure
+	RetryIn time.Duration
+}
+
+//SessionRefresherSettings configures the background renewal of sessions
+type SessionRefresherSettings struct {
+	//Margin is how long before the expiry the session is renewed, DefaultSessionRenewalMargin if not set
+	Margin time.Duration
+	//MinBackoff is the waiting time after the first failed login, it's doubled after each failure till MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	//OnEvent receives the renewal events, it's called from the background goroutine
+	OnEvent func(event SessionEvent)
+}
+
+//SessionRefresher renews the session of DynamicSessionProvider in the background before it expires,
+//so the requests never wait for a login or fail with an expired session
+type SessionRefresher struct {
+	provider *DynamicSessionProvider
+	settings SessionRefresherSettings
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	//lifetime is the validity duration of the last renewed session, it's used only by the background goroutine
+	lifetime time.Duration
+}
+
+//StartRefresher starts renewing the session in the background, call Stop on the refresher or Close on the client to stop it
+func (dsp *DynamicSessionProvider) StartRefresher(settings SessionRefresherSettings) *SessionRefresher {
+	if settings.Margin <= 0 {
+		settings.Margin = DefaultSessionRenewalMargin
+	}
+	if settings.MinBackoff <= 0 {
+		settings.MinBackoff = DefaultSessionRenewalMinBackoff
+	}
+	if settings.MaxBackoff < settings.MinBackoff {
+		settings.MaxBackoff = DefaultSessionRenewalMaxBackoff
+		if settings.MaxBackoff < settings.MinBackoff {
+			settings.MaxBackoff = settings.MinBackoff
+		}
+	}
+
+	sr := &SessionRefresher{
+		provider: dsp,
+		settings: settings,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go sr.run()
+
+	return sr
+}
+
+//Stop stops the renewal and waits till the background goroutine exits, it can be called several times
+func (sr *SessionRefresher) Stop() {
+	sr.stopOnce.Do(func() {
+		close(sr.stop)
+	})
+	<-sr.done
+}
+
+func (sr *SessionRefresher) run() {
+	defer close(sr.done)
+
+	sr.syncExpiry()
+
+	failures := 0
+	for {
+		var wait time.Duration
+		if failures > 0 {
+			wait = sr.backoff(failures)
+		} else {
+			wait = sr.timeTillRenewal()
+		}
+
+		if wait > 0 {
+			if !sr.sleep(wait) {
+				return
+			}
+			//the session might have been renewed by a request meanwhile
+			if failures == 0 && sr.timeTillRenewal() > 0 {
+				continue
+			}
+		}
+
+		select {
+		case <-sr.stop:
+			return
+		default:
+		}
+
+		validTill, err := sr.renew()
+		if err != nil {
+			failures++
+			retryIn := sr.backoff(failures)
+			log.Log.Log(log.Warn, "failed to renew the session, will retry in %v: %v", retryIn, err)
+			sr.emit(SessionEvent{Type: SessionRenewalFailed, Err: err, RetryIn: retryIn})
+			continue
+		}
+
+		failures = 0
+		log.Log.Log(log.Debug, "renewed the session in the background, it's valid till %v", validTill)
+		sr.emit(SessionEvent{Type: SessionRenewed, ValidTill: validTill})
+	}
+}
+
+//syncExpiry replaces the estimated expiry of the current session with the one known by the API
+func (sr *SessionRefresher) syncExpiry() {
+	dsp := sr.provider
+	dsp.Lock.Lock()
+	sessionKey := dsp.SessionKey
+	dsp.Lock.Unlock()
+	if sessionKey == "" {
+		return
+	}
+
+	expiresAt, err := dsp.getSessionExpiry(sessionKey)
+	if err != nil {
+		log.Log.Log(log.Debug, "failed to get the expiry time of the session: %v", err)
+		return
+	}
+
+	dsp.Lock.Lock()
+	defer dsp.Lock.Unlock()
+	if dsp.SessionKey == sessionKey {
+		dsp.SessionValidTill = &expiresAt
+	}
+}
+
+//timeTillRenewal gives the waiting time till the current session should be renewed, 0 if there is no valid session
+func (sr *SessionRefresher) timeTillRenewal() time.Duration {
+	dsp := sr.provider
+	dsp.Lock.Lock()
+	defer dsp.Lock.Unlock()
+
+	if dsp.SessionKey == "" || dsp.SessionValidTill == nil {
+		return 0
+	}
+
+	//a margin longer than the session itself would renew the session again right after the renewal
+	margin := sr.settings.Margin
+	if sr.lifetime > 0 && margin > sr.lifetime/2 {
+		margin = sr.lifetime / 2
+	}
+
+	wait := time.Until(dsp.SessionValidTill.Add(-margin))
+	if wait < 0 {
+		return 0
+	}
+
+	return wait
+}
+
+//renew logs in without holding the provider lock, so the requests keep using the old session till the new one is ready
+func (sr *SessionRefresher) renew() (time.Time, error) {
+	dsp := sr.provider
+	renewedAt := time.Now()
+	sessionKey, validTill, err := dsp.getAuthUserFromAPI()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if expiresAt, err := dsp.getSessionExpiry(sessionKey); err == nil {
+		validTill = &expiresAt
+	} else {
+		log.Log.Log(log.Debug, "failed to get the expiry time of the new session, will use the session length: %v", err)
+	}
+
+	sr.lifetime = validTill.Sub(renewedAt)
+
+	dsp.Lock.Lock()
+	defer dsp.Lock.Unlock()
+
+	dsp.SessionKey = sessionKey
+	dsp.SessionValidTill = validTill
+	if dsp.Store != nil {
+		dsp.saveStoredSession(sessionKey, validTill)
+	}
+	if dsp.Metrics != nil {
+		dsp.Metrics.SessionRefreshed()
+	}
+
+	return *validTill, nil
+}
+
+func (sr *SessionRefresher) backoff(failures int) time.Duration {
+	backoff := sr.settings.MinBackoff
+	for i := 1; i < failures && backoff < sr.settings.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > sr.settings.MaxBackoff {
+		backoff = sr.settings.MaxBackoff
+	}
+
+	return backoff
+}
+
+func (sr *SessionRefresher) sleep(dur time.Duration) bool {
+	timer := time.NewTimer(dur)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-sr.stop:
+		return false
+	}
+}
+
+func (sr *SessionRefresher) emit(event SessionEvent) {
+	if sr.settings.OnEvent != nil {
+		sr.settings.OnEvent(event)
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type sessionEventsRecorder struct {
+	lock   sync.Mutex
+	events []SessionEvent
+}
+
+func (ser *sessionEventsRecorder) record(event SessionEvent) {
+	ser.lock.Lock()
+	defer ser.lock.Unlock()
+	ser.events = append(ser.events, event)
+}
+
+func (ser *sessionEventsRecorder) types() []SessionEventType {
+	ser.lock.Lock()
+	defer ser.lock.Unlock()
+
+	res := make([]SessionEventType, 0, len(ser.events))
+	for _, event := range ser.events {
+		res = append(res, event.Type)
+	}
+	return res
+}
+
+func TestSessionRefresher(t *testing.T) {
+	srv := newSessionsServer()
+	defer srv.Close()
+	srv.sessionLen = 3 * time.Second
+	srv.failedLogins = 1
+
+	events := &sessionEventsRecorder{}
+	cli := ClientBuilder{
+		UserName:   "john",
+		Password:   "pass",
+		ClientCode: "123",
+		HttpCli:    srv.httpClient(),
+		SessionRefresh: &SessionRefresherSettings{
+			Margin:     2500 * time.Millisecond,
+			MinBackoff: 50 * time.Millisecond,
+			OnEvent:    events.record,
+		},
+	}.Build()
+
+	assert.Eventually(t, func() bool {
+		return len(events.types()) >= 2
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []SessionEventType{SessionRenewalFailed, SessionRenewed}, events.types()[:2])
+	events.lock.Lock()
+	assert.Equal(t, 50*time.Millisecond, events.events[0].RetryIn)
+	assert.Error(t, events.events[0].Err)
+	events.lock.Unlock()
+
+	sessionKey, err := cli.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", sessionKey)
+
+	//the margin is longer than half of the session, so the session is renewed in the middle of its lifetime
+	assert.Eventually(t, func() bool {
+		sessionKey, err := cli.GetSession()
+		return err == nil && sessionKey == "key3"
+	}, 3*time.Second, 10*time.Millisecond)
+
+	err = cli.Call(context.Background(), "getServerTime", nil, nil)
+	assert.NoError(t, err)
+
+	cli.Close()
+	srv.lock.Lock()
+	verifyCalls := srv.verifyCalls
+	srv.lock.Unlock()
+
+	time.Sleep(700 * time.Millisecond)
+	srv.lock.Lock()
+	assert.Equal(t, verifyCalls, srv.verifyCalls, "no logins should happen after the client is closed")
+	srv.lock.Unlock()
+}
+
+func TestSessionRefresherBackoff(t *testing.T) {
+	sr := &SessionRefresher{settings: SessionRefresherSettings{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+
+	assert.Equal(t, time.Second, sr.backoff(1))
+	assert.Equal(t, 2*time.Second, sr.backoff(2))
+	assert.Equal(t, 4*time.Second, sr.backoff(3))
+	assert.Equal(t, 5*time.Second, sr.backoff(4))
+	assert.Equal(t, 5*time.Second, sr.backoff(10))
+}
+
+type failingTransport struct {
+	lock     sync.Mutex
+	rawQuery []string
+}
+
+func (ft *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ft.lock.Lock()
+	ft.rawQuery = append(ft.rawQuery, req.URL.RawQuery)
+	ft.lock.Unlock()
+
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, errors.New("connection refused")
+}
+
+func TestSessionRefresherHidesCredentials(t *testing.T) {
+	transport := &failingTransport{}
+	events := &sessionEventsRecorder{}
+	cli := ClientBuilder{
+		UserName:   "john",
+		Password:   "secretPass",
+		ClientCode: "123",
+		HttpCli:    &http.Client{Transport: transport},
+		SessionRefresh: &SessionRefresherSettings{
+			MinBackoff: time.Second,
+			OnEvent:    events.record,
+		},
+	}.Build()
+	defer cli.Close()
+
+	assert.Eventually(t, func() bool {
+		return len(events.types()) >= 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	events.lock.Lock()
+	assert.Equal(t, SessionRenewalFailed, events.events[0].Type)
+	assert.Error(t, events.events[0].Err)
+	assert.NotContains(t, events.events[0].Err.Error(), "secretPass")
+	assert.True(t, errors.Is(events.events[0].Err, sharedCommon.ErrTransport))
+	events.lock.Unlock()
+
+	transport.lock.Lock()
+	assert.NotContains(t, transport.rawQuery[0], "secretPass")
+	transport.lock.Unlock()
+}
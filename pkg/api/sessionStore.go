@@ -0,0 +1,270 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+const (
+	//DefaultSessionFileLockTimeout is how long FileSessionStore waits for the lock of the file
+	DefaultSessionFileLockTimeout = 10 * time.Second
+	//sessionFileLockStaleAge is the age after which a lock file is considered as left by a crashed process
+	sessionFileLockStaleAge      = time.Minute
+	sessionFileLockRetryInterval = 20 * time.Millisecond
+)
+
+//ErrSessionFileLocked is returned when the lock of the session file cannot be acquired in time
+var ErrSessionFileLocked = errors.New("session file is locked by another process")
+
+//StoredSession is a session key persisted by a SessionStore
+type StoredSession struct {
+	SessionKey string    `json:"sessionKey"`
+	ValidTill  time.Time `json:"validTill"`
+}
+
+//SessionStore persists the session keys of DynamicSessionProvider per client code and user name,
+//so they can be reused after a process restart or by other processes
+type SessionStore interface {
+	//Load gives the stored session or nil if there is none
+	Load(clientCode, userName string) (*StoredSession, error)
+	Save(clientCode, userName string, session StoredSession) error
+	//DeleteIfKey removes the stored session only if it has the given session key, the check and the removal must be atomic
+	//since another process might have already stored a new session
+	DeleteIfKey(clientCode, userName, sessionKey string) error
+}
+
+func sessionStoreKey(clientCode, userName string) string {
+	return clientCode + "/" + userName
+}
+
+//MemorySessionStore keeps the sessions in memory, it can be shared by the clients of one process
+type MemorySessionStore struct {
+	lock     sync.Mutex
+	sessions map[string]StoredSession
+}
+
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: map[string]StoredSession{}}
+}
+
+func (mss *MemorySessionStore) Load(clientCode, userName string) (*StoredSession, error) {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+
+	session, ok := mss.sessions[sessionStoreKey(clientCode, userName)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &session, nil
+}
+
+func (mss *MemorySessionStore) Save(clientCode, userName string, session StoredSession) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+
+	mss.sessions[sessionStoreKey(clientCode, userName)] = session
+	return nil
+}
+
+func (mss *MemorySessionStore) DeleteIfKey(clientCode, userName, sessionKey string) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+
+	key := sessionStoreKey(clientCode, userName)
+	if session, ok := mss.sessions[key]; ok && session.SessionKey == sessionKey {
+		delete(mss.sessions, key)
+	}
+	return nil
+}
+
+//FileSessionStore keeps the sessions in a JSON file which can be shared by several processes,
+//every access is guarded by a lock file next to it. The file contains session keys, so it's created only readable for the owner
+type FileSessionStore struct {
+	Path string
+	//LockTimeout is how long to wait for the lock held by another process, DefaultSessionFileLockTimeout if not set
+	LockTimeout time.Duration
+	lock        sync.Mutex
+}
+
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{Path: path}
+}
+
+func (fss *FileSessionStore) Load(clientCode, userName string) (*StoredSession, error) {
+	var res *StoredSession
+	err := fss.withLock(func() error {
+		sessions, err := fss.read()
+		if err != nil {
+			return err
+		}
+		if session, ok := sessions[sessionStoreKey(clientCode, userName)]; ok {
+			res = &session
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+func (fss *FileSessionStore) Save(clientCode, userName string, session StoredSession) error {
+	return fss.withLock(func() error {
+		sessions, err := fss.read()
+		if err != nil {
+			return err
+		}
+		sessions[sessionStoreKey(clientCode, userName)] = session
+		return fss.write(sessions)
+	})
+}
+
+func (fss *FileSessionStore) DeleteIfKey(clientCode, userName, sessionKey string) error {
+	return fss.withLock(func() error {
+		sessions, err := fss.read()
+		if err != nil {
+			return err
+		}
+		key := sessionStoreKey(clientCode, userName)
+		if session, ok := sessions[key]; !ok || session.SessionKey != sessionKey {
+			return nil
+		}
+		delete(sessions, key)
+		return fss.write(sessions)
+	})
+}
+
+//withLock runs f while holding the lock of this process and the lock file shared with other processes
+func (fss *FileSessionStore) withLock(f func() error) error {
+	fss.lock.Lock()
+	defer fss.lock.Unlock()
+
+	timeout := fss.LockTimeout
+	if timeout <= 0 {
+		timeout = DefaultSessionFileLockTimeout
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fss.Path), 0700); err != nil {
+		return fmt.Errorf("failed to create directory for the session file %s: %v", fss.Path, err)
+	}
+
+	lockPath := fss.Path + ".lock"
+	deadline := time.Now().Add(timeout)
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, _ = lockFile.WriteString(strconv.Itoa(os.Getpid()))
+			lockFile.Close()
+			break
+		}
+		if !os.IsExist(err) {
+			return fmt.Errorf("failed to lock the session file %s: %v", fss.Path, err)
+		}
+
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > sessionFileLockStaleAge {
+			log.Log.Log(log.Warn, "will remove the stale lock of the session file %s", fss.Path)
+			_ = os.Remove(lockPath)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w: %s", ErrSessionFileLocked, fss.Path)
+		}
+		time.Sleep(sessionFileLockRetryInterval)
+	}
+	defer os.Remove(lockPath)
+
+	return f()
+}
+
+func (fss *FileSessionStore) read() (map[string]StoredSession, error) {
+	sessions := map[string]StoredSession{}
+
+	data, err := ioutil.ReadFile(fss.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return sessions, nil
+		}
+		return nil, fmt.Errorf("failed to read the session file %s: %v", fss.Path, err)
+	}
+	if len(data) == 0 {
+		return sessions, nil
+	}
+
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		return nil, fmt.Errorf("failed to decode the session file %s: %v", fss.Path, err)
+	}
+
+	return sessions, nil
+}
+
+//write replaces the file atomically, so a crash cannot leave a broken file
+func (fss *FileSessionStore) write(sessions map[string]StoredSession) error {
+	data, err := json.MarshalIndent(sessions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := fss.Path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write the session file %s: %v", tmpPath, err)
+	}
+
+	return os.Rename(tmpPath, fss.Path)
+}
+
+//loadStoredSession gives the stored session if the API confirms that it's still valid, it should be called under the provider lock
+func (dsp *DynamicSessionProvider) loadStoredSession() (sessionKey string, validTill *time.Time, ok bool) {
+	stored, err := dsp.Store.Load(dsp.ClientCode, dsp.UserName)
+	if err != nil {
+		log.Log.Log(log.Warn, "failed to load the stored session: %v", err)
+		return "", nil, false
+	}
+	if stored == nil || stored.SessionKey == "" || !stored.ValidTill.After(time.Now()) {
+		return "", nil, false
+	}
+
+	expiresAt, err := dsp.getSessionExpiry(stored.SessionKey)
+	if err != nil {
+		log.Log.Log(log.Debug, "failed to validate the stored session: %v", err)
+		var erplyErr *sharedCommon.ErplyError
+		if errors.As(err, &erplyErr) && erplyErr.Code != 0 {
+			dsp.deleteStoredSession(stored.SessionKey)
+		}
+		return "", nil, false
+	}
+
+	if !expiresAt.After(time.Now()) {
+		dsp.deleteStoredSession(stored.SessionKey)
+		return "", nil, false
+	}
+
+	return stored.SessionKey, &expiresAt, true
+}
+
+func (dsp *DynamicSessionProvider) saveStoredSession(sessionKey string, validTill *time.Time) {
+	session := StoredSession{SessionKey: sessionKey}
+	if validTill != nil {
+		session.ValidTill = *validTill
+	}
+
+	if err := dsp.Store.Save(dsp.ClientCode, dsp.UserName, session); err != nil {
+		log.Log.Log(log.Warn, "failed to store the session: %v", err)
+	}
+}
+
+//deleteStoredSession removes the stored session only if it's the given one, since another process might have already stored a new session
+func (dsp *DynamicSessionProvider) deleteStoredSession(sessionKey string) {
+	if err := dsp.Store.DeleteIfKey(dsp.ClientCode, dsp.UserName, sessionKey); err != nil {
+		log.Log.Log(log.Warn, "failed to delete the stored session: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sessionsServer struct {
+	*httptest.Server
+	lock        sync.Mutex
+	sessions    map[string]time.Time
+	verifyCalls int
+	infoCalls   int
+	//sessionLen is the length of the new sessions, an hour if not set
+	sessionLen time.Duration
+	//failedLogins is the amount of the next verifyUser calls which fail
+	failedLogins int
+}
+
+func newSessionsServer() *sessionsServer {
+	ss := &sessionsServer{sessions: map[string]time.Time{}}
+	ss.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ss.lock.Lock()
+		defer ss.lock.Unlock()
+
+		switch r.FormValue("request") {
+		case "verifyUser":
+			ss.verifyCalls++
+			if ss.failedLogins > 0 {
+				ss.failedLogins--
+				fmt.Fprint(w, `{"status":{"responseStatus":"error","errorCode":1000},"records":[]}`)
+				return
+			}
+			sessionLen := ss.sessionLen
+			if sessionLen == 0 {
+				sessionLen = time.Hour
+			}
+			sessionKey := fmt.Sprintf("key%d", ss.verifyCalls)
+			ss.sessions[sessionKey] = time.Now().Add(sessionLen)
+			fmt.Fprintf(w, `{"status":{"responseStatus":"ok"},"records":[{"sessionKey":%q,"sessionLength":%d}]}`, sessionKey, int(sessionLen.Seconds()))
+		case "getSessionKeyInfo":
+			ss.infoCalls++
+			validTill, ok := ss.sessions[r.FormValue("sessionKey")]
+			if !ok {
+				fmt.Fprint(w, `{"status":{"responseStatus":"error","errorCode":1054},"records":[]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"status":{"responseStatus":"ok"},"records":[{"creationUnixTime":"1","expireUnixTime":"%d"}]}`, validTill.Unix())
+		default:
+			fmt.Fprint(w, `{"status":{"responseStatus":"ok"},"records":[]}`)
+		}
+	}))
+
+	return ss
+}
+
+//httpClient sends all requests to the test server, since the session provider calls the production URL
+func (ss *sessionsServer) httpClient() *http.Client {
+	return &http.Client{Transport: redirectingTransport{target: ss.URL}}
+}
+
+type redirectingTransport struct {
+	target string
+}
+
+func (rt redirectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	targetURL, err := url.Parse(rt.target)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = targetURL.Scheme
+	req.URL.Host = targetURL.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestFileSessionStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions", "erply.json")
+	store := NewFileSessionStore(path)
+
+	session, err := store.Load("123", "john")
+	assert.NoError(t, err)
+	assert.Nil(t, session)
+
+	validTill := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	assert.NoError(t, store.Save("123", "john", StoredSession{SessionKey: "key1", ValidTill: validTill}))
+	assert.NoError(t, store.Save("123", "jane", StoredSession{SessionKey: "key2", ValidTill: validTill}))
+
+	session, err = NewFileSessionStore(path).Load("123", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, &StoredSession{SessionKey: "key1", ValidTill: validTill}, session)
+
+	//a session which was replaced by another process is kept
+	assert.NoError(t, store.DeleteIfKey("123", "john", "oldKey"))
+	session, err = store.Load("123", "john")
+	assert.NoError(t, err)
+	assert.NotNil(t, session)
+
+	assert.NoError(t, store.DeleteIfKey("123", "john", "key1"))
+	session, err = store.Load("123", "john")
+	assert.NoError(t, err)
+	assert.Nil(t, session)
+	session, err = store.Load("123", "jane")
+	assert.NoError(t, err)
+	assert.NotNil(t, session)
+
+	//another process holding the lock file
+	lockedStore := &FileSessionStore{Path: path}
+	err = lockedStore.withLock(func() error {
+		_, err := (&FileSessionStore{Path: path, LockTimeout: 50 * time.Millisecond}).Load("123", "jane")
+		return err
+	})
+	assert.True(t, errors.Is(err, ErrSessionFileLocked))
+
+	session, err = store.Load("123", "jane")
+	assert.NoError(t, err)
+	assert.NotNil(t, session)
+}
+
+func TestDynamicSessionProviderWithStore(t *testing.T) {
+	srv := newSessionsServer()
+	defer srv.Close()
+
+	store := NewMemorySessionStore()
+	newProvider := func() *DynamicSessionProvider {
+		return &DynamicSessionProvider{
+			ClientCode: "123",
+			UserName:   "john",
+			Pass:       "pass",
+			HTTPClient: srv.httpClient(),
+			Store:      store,
+		}
+	}
+
+	sessionKey, err := newProvider().GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, "key1", sessionKey)
+	assert.Equal(t, 1, srv.verifyCalls)
+
+	//a restarted process reuses the stored key after validating it
+	provider := newProvider()
+	sessionKey, err = provider.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, "key1", sessionKey)
+	assert.Equal(t, 1, srv.verifyCalls)
+	assert.Equal(t, 1, srv.infoCalls)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *provider.SessionValidTill, 2*time.Second)
+
+	//a key which the API doesn't know anymore is replaced
+	srv.lock.Lock()
+	delete(srv.sessions, "key1")
+	srv.lock.Unlock()
+
+	sessionKey, err = newProvider().GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", sessionKey)
+	assert.Equal(t, 2, srv.verifyCalls)
+
+	stored, err := store.Load("123", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", stored.SessionKey)
+
+	provider.Invalidate()
+	stored, err = store.Load("123", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", stored.SessionKey, "a session stored by another provider should not be deleted")
+}
+
+func TestClientBuilderWithSessionStore(t *testing.T) {
+	srv := newSessionsServer()
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	for i := 0; i < 3; i++ {
+		cli := ClientBuilder{
+			UserName:     "john",
+			Password:     "pass",
+			ClientCode:   "123",
+			HttpCli:      srv.httpClient(),
+			SessionStore: NewFileSessionStore(path),
+		}.Build()
+
+		err := cli.Call(context.Background(), "getServerTime", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, srv.verifyCalls)
+	assert.Equal(t, 2, srv.infoCalls)
+}
@@ -0,0 +1,59 @@
+package warehouse
+
+import (
+	"context"
+	"time"
+
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//WarehousesIterator gives the warehouses of a listing one by one
+type WarehousesIterator struct {
+	*sharedCommon.ItemsIterator
+	value Warehouse
+}
+
+//IterateWarehouses lists the warehouses matching the filters with ListingDataProvider, call Close if the iteration is stopped before Next returns false
+func IterateWarehouses(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings) *WarehousesIterator {
+	lister := sharedCommon.NewLister(settings, NewListingDataProvider(erplyClient), time.Sleep)
+	return NewWarehousesIterator(ctx, lister, filters)
+}
+
+//NewWarehousesIterator iterates over the items of a lister which was created with NewListingDataProvider
+func NewWarehousesIterator(ctx context.Context, lister *sharedCommon.Lister, filters map[string]interface{}) *WarehousesIterator {
+	return &WarehousesIterator{ItemsIterator: sharedCommon.NewItemsIterator(ctx, lister, filters)}
+}
+
+func (it *WarehousesIterator) Next() bool {
+	if !it.ItemsIterator.Next() {
+		return false
+	}
+
+	value, ok := it.Payload().(Warehouse)
+	if !ok {
+		it.FailUnexpectedPayload("warehouse.Warehouse")
+		return false
+	}
+	it.value = value
+
+	return true
+}
+
+//Value gives the current item
+func (it *WarehousesIterator) Value() Warehouse {
+	return it.value
+}
+
+//ForEachWarehouse calls f for every item of IterateWarehouses, the iteration stops at the first error of the listing or f
+func ForEachWarehouse(ctx context.Context, erplyClient Manager, filters map[string]interface{}, settings sharedCommon.ListingSettings, f func(warehouse Warehouse) error) error {
+	it := IterateWarehouses(ctx, erplyClient, filters, settings)
+	defer it.Close()
+
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}